@@ -0,0 +1,111 @@
+package gbfs
+
+import (
+	"sort"
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+// Alerts is a list of alerts of system_alerts, each query return a new list who can be queried again
+//
+//	system.Alerts().ActiveAt(time.Now()).AffectingStation(s.ID, s.RegionID)
+type Alerts []gbfsspec.SystemAlert
+
+// Alerts return the alerts of the system, empty when system_alerts is not published
+func (s *System) Alerts() Alerts {
+	if s.SystemAlerts == nil {
+		return nil
+	}
+
+	return s.SystemAlerts.Data.Alerts
+}
+
+// ActiveAt return the alerts active at the instant
+// An alert without time window is always active, and a window without end is open-ended
+func (aa Alerts) ActiveAt(at time.Time) Alerts {
+	var active Alerts
+
+	for _, a := range aa {
+		if len(a.Times) == 0 {
+			active = append(active, a)
+			continue
+		}
+
+		for _, t := range a.Times {
+			if !at.Before(t.Start.ToTime()) && (t.End == 0 || at.Before(t.End.ToTime())) {
+				active = append(active, a)
+				break
+			}
+		}
+	}
+
+	return active
+}
+
+// AffectingStation return the alerts targeting the station, its region (ignored when empty) or the whole system
+// An alert without station and region is system-wide
+func (aa Alerts) AffectingStation(stationID, regionID string) Alerts {
+	var affecting Alerts
+
+	for _, a := range aa {
+		if len(a.StationIDs) == 0 && len(a.RegionIDs) == 0 {
+			affecting = append(affecting, a)
+			continue
+		}
+
+		if contains(a.StationIDs, stationID) || (regionID != "" && contains(a.RegionIDs, regionID)) {
+			affecting = append(affecting, a)
+		}
+	}
+
+	return affecting
+}
+
+// Upcoming return the alerts with a time window starting after the instant, within the horizon,
+// ordered by their next start
+func (aa Alerts) Upcoming(at time.Time, horizon time.Duration) Alerts {
+	type upcoming struct {
+		alert gbfsspec.SystemAlert
+		start time.Time
+	}
+
+	var uu []upcoming
+
+	limit := at.Add(horizon)
+
+	for _, a := range aa {
+		var next time.Time
+
+		for _, t := range a.Times {
+			s := t.Start.ToTime()
+
+			if s.After(at) && !s.After(limit) && (next.IsZero() || s.Before(next)) {
+				next = s
+			}
+		}
+
+		if !next.IsZero() {
+			uu = append(uu, upcoming{alert: a, start: next})
+		}
+	}
+
+	sort.SliceStable(uu, func(i, j int) bool { return uu[i].start.Before(uu[j].start) })
+
+	var sorted Alerts
+	for _, u := range uu {
+		sorted = append(sorted, u.alert)
+	}
+
+	return sorted
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
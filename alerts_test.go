@@ -0,0 +1,103 @@
+package gbfs
+
+import (
+	"testing"
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+var testAlerts = Alerts{
+	// system-wide, always active
+	{AlertID: "system"},
+	{AlertID: "station", StationIDs: []string{"1"}, Times: []gbfsspec.SystemAlertTime{{Start: 1000, End: 2000}}},
+	{AlertID: "region", RegionIDs: []string{"r1"}, Times: []gbfsspec.SystemAlertTime{{Start: 1500}}},
+	{AlertID: "later", StationIDs: []string{"2"}, Times: []gbfsspec.SystemAlertTime{{Start: 5000, End: 6000}, {Start: 3000, End: 4000}}},
+}
+
+func alertIDs(aa Alerts) string {
+	ids := ""
+	for _, a := range aa {
+		ids += a.AlertID + " "
+	}
+
+	return ids
+}
+
+func TestAlerts_ActiveAt(t *testing.T) {
+	ii := []struct {
+		at  int64
+		out string
+	}{
+		{at: 500, out: "system "},
+		{at: 1000, out: "system station "},
+		{at: 1999, out: "system station region "},
+		{at: 2000, out: "system region "},
+		// open-ended
+		{at: 100000, out: "system region "},
+		{at: 3500, out: "system region later "},
+	}
+
+	for _, i := range ii {
+		if o := alertIDs(testAlerts.ActiveAt(time.Unix(i.at, 0))); o != i.out {
+			t.Errorf("expect '%s' got '%s' at '%d'", i.out, o, i.at)
+		}
+	}
+}
+
+func TestAlerts_AffectingStation(t *testing.T) {
+	ii := []struct {
+		station, region string
+		out             string
+	}{
+		{station: "1", region: "r1", out: "system station region "},
+		{station: "1", out: "system station "},
+		{station: "3", region: "r1", out: "system region "},
+		{station: "3", region: "r2", out: "system "},
+	}
+
+	for _, i := range ii {
+		if o := alertIDs(testAlerts.AffectingStation(i.station, i.region)); o != i.out {
+			t.Errorf("expect '%s' got '%s'", i.out, o)
+		}
+	}
+
+	// queries are chained
+	if o := alertIDs(testAlerts.ActiveAt(time.Unix(500, 0)).AffectingStation("1", "r1")); o != "system " {
+		t.Errorf("expect 'system ' got '%s'", o)
+	}
+}
+
+func TestAlerts_Upcoming(t *testing.T) {
+	ii := []struct {
+		at      int64
+		horizon time.Duration
+		out     string
+	}{
+		{at: 0, horizon: time.Hour, out: "station region later "},
+		{at: 1200, horizon: 1000 * time.Second, out: "region "},
+		{at: 2500, horizon: 1000 * time.Second, out: "later "},
+		{at: 3000, horizon: 1000 * time.Second, out: ""},
+		{at: 3000, horizon: 2000 * time.Second, out: "later "},
+	}
+
+	for _, i := range ii {
+		if o := alertIDs(testAlerts.Upcoming(time.Unix(i.at, 0), i.horizon)); o != i.out {
+			t.Errorf("expect '%s' got '%s' at '%d'", i.out, o, i.at)
+		}
+	}
+}
+
+func TestSystem_Alerts(t *testing.T) {
+	var s System
+
+	if aa := s.Alerts(); len(aa) != 0 {
+		t.Errorf("expect '0' got '%d'", len(aa))
+	}
+
+	s.SystemAlerts = &gbfsspec.FeedSystemAlerts{Data: gbfsspec.SystemAlertsData{Alerts: testAlerts}}
+
+	if aa := s.Alerts(); len(aa) != 4 {
+		t.Errorf("expect '4' got '%d'", len(aa))
+	}
+}
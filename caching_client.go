@@ -0,0 +1,280 @@
+package gbfs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync"
+	"time"
+)
+
+type (
+	// CachingOption for CachingClient
+	CachingOption func(*CachingClient)
+
+	// CachingClient wrap a gbfs.Client and keep the feeds in memory until they expire (last_updated + ttl)
+	// Concurrent fetches of the same feed are collapsed into a single request to the wrapped client.
+	// Each caller receive its own copy of the feed.
+	CachingClient struct {
+		client   Client
+		language string
+
+		mu       sync.Mutex
+		entries  map[string]cacheEntry
+		inflight map[string]*cacheCall
+	}
+
+	cacheEntry struct {
+		// decoded feed, only used to know when the entry expire
+		feed Feed
+
+		// feed encoded in JSON, decoded in the structure of each caller
+		raw []byte
+
+		// when the entry expire, set when the feed was revalidated (zero to use the one of the feed)
+		expireAt time.Time
+	}
+
+	cacheCall struct {
+		done  chan struct{}
+		entry cacheEntry
+		err   error
+	}
+)
+
+// NewCachingClient return a gbfs.Client who cache the feeds fetched with 'c'
+func NewCachingClient(c Client, opts ...CachingOption) Client {
+	cc := &CachingClient{
+		client:   c,
+		entries:  make(map[string]cacheEntry),
+		inflight: make(map[string]*cacheCall),
+	}
+
+	for _, opt := range opts {
+		opt(cc)
+	}
+
+	return cc
+}
+
+// ForceURLs set the full URL for each feed on the wrapped client, and clear the cache
+func (c *CachingClient) ForceURLs(urls map[string]string, replace bool) {
+	c.client.ForceURLs(urls, replace)
+
+	c.mu.Lock()
+	c.entries = make(map[string]cacheEntry)
+	c.mu.Unlock()
+}
+
+// Version return the version of the spec of the wrapped client, empty when it doesn't implement VersionedClient
+func (c *CachingClient) Version() string {
+	if vc, ok := c.client.(VersionedClient); ok {
+		return vc.Version()
+	}
+
+	return ""
+}
+
+// Negotiate return the version of the spec negotiated by the wrapped client
+// ErrAutoDiscoveryMissing is returned when it doesn't implement VersionedClient
+func (c *CachingClient) Negotiate(ctx context.Context) (string, error) {
+	if vc, ok := c.client.(VersionedClient); ok {
+		return vc.Negotiate(ctx)
+	}
+
+	return "", ErrAutoDiscoveryMissing
+}
+
+// Get one feed, from the cache when not expired, and decode it in 'out' structure
+func (c *CachingClient) Get(key string, out Feed) error {
+	return c.GetContext(context.Background(), key, out)
+}
+
+// GetContext get one feed, from the cache when not expired, and decode it in 'out' structure
+func (c *CachingClient) GetContext(ctx context.Context, key string, out Feed) error {
+	if out.FeedKey() != key {
+		return ErrInvalidFeed
+	}
+
+	e, err := c.get(ctx, key, out, false)
+	if err != nil {
+		return err
+	}
+
+	return e.decode(out)
+}
+
+// Refresh the feed when is expired or forced (via 'forceRefresh')
+func (c *CachingClient) Refresh(f Feed, forceRefresh bool) error {
+	return c.RefreshContext(context.Background(), f, forceRefresh)
+}
+
+// RefreshContext refresh the feed when is expired or forced (via 'forceRefresh')
+// The feed is served from the cache when the cached copy is not expired, unless forced
+// The content of the feed is replaced by a new structure, the previous one is never written in
+func (c *CachingClient) RefreshContext(ctx context.Context, f Feed, forceRefresh bool) error {
+	// not forced and feed not expired
+	if !forceRefresh && !f.IsExpired() {
+		return nil
+	}
+
+	e, err := c.get(ctx, f.FeedKey(), f, forceRefresh)
+	if err != nil {
+		return err
+	}
+
+	return e.replace(f)
+}
+
+// get return the cached feed when not expired (or 'force' is false),
+// or fetch it, waiting for the fetch in progress for the same feed if any
+func (c *CachingClient) get(ctx context.Context, key string, out Feed, force bool) (cacheEntry, error) {
+	k := c.language + "/" + key
+
+	for {
+		c.mu.Lock()
+
+		e, cached := c.entries[k]
+		if cached && !force && !e.expired() {
+			c.mu.Unlock()
+			return e, nil
+		}
+
+		if call, ok := c.inflight[k]; ok {
+			c.mu.Unlock()
+
+			select {
+			case <-ctx.Done():
+				return cacheEntry{}, ctx.Err()
+			case <-call.done:
+			}
+
+			// the fetch was aborted by the context of another caller, try again with ours
+			if isContextError(call.err) && ctx.Err() == nil {
+				continue
+			}
+
+			return call.entry, call.err
+		}
+
+		call := &cacheCall{done: make(chan struct{})}
+		c.inflight[k] = call
+		c.mu.Unlock()
+
+		var prev *cacheEntry
+		if cached {
+			prev = &e
+		}
+
+		call.entry, call.err = c.fetch(ctx, key, out, prev)
+
+		c.mu.Lock()
+		delete(c.inflight, k)
+		if call.err == nil {
+			c.entries[k] = call.entry
+		}
+		c.mu.Unlock()
+
+		close(call.done)
+
+		return call.entry, call.err
+	}
+}
+
+// expired return true when the entry must be fetched again
+func (e cacheEntry) expired() bool {
+	if !e.expireAt.IsZero() {
+		return !time.Now().Before(e.expireAt)
+	}
+
+	return e.feed.IsExpired()
+}
+
+// decode the cached feed in 'out', with the decoding warnings not encoded in JSON
+func (e cacheEntry) decode(out Feed) error {
+	if err := json.Unmarshal(e.raw, out); err != nil {
+		return err
+	}
+
+	if from, ok := e.feed.(decodeWarner); ok {
+		if to, ok := out.(decodeWarner); ok {
+			to.SetDecodeWarnings(from.DecodeWarnings())
+		}
+	}
+
+	return nil
+}
+
+// replace 'out', who may hold a previous version, by a new structure decoded from the cached feed
+func (e cacheEntry) replace(out Feed) error {
+	f, err := newFeed(out)
+	if err != nil {
+		return err
+	}
+
+	if err := e.decode(f); err != nil {
+		return err
+	}
+
+	reflect.ValueOf(out).Elem().Set(reflect.ValueOf(f).Elem())
+
+	return nil
+}
+
+// fetch the feed with the wrapped client, in a new structure of the same type of 'like'
+// When 'prev' is set, the structure hold the cached copy and is refreshed. When the wrapped client tell us
+// that the copy is still the latest one (ErrNotModified), it's kept for the ttl of the feed.
+func (c *CachingClient) fetch(ctx context.Context, key string, like Feed, prev *cacheEntry) (cacheEntry, error) {
+	f, err := newFeed(like)
+	if err != nil {
+		return cacheEntry{}, err
+	}
+
+	if prev != nil && prev.decode(f) == nil {
+		err = c.client.RefreshContext(ctx, f, true)
+	} else {
+		err = c.client.GetContext(ctx, key, f)
+	}
+
+	if errors.Is(err, ErrNotModified) && prev != nil {
+		return prev.revalidated(time.Now()), nil
+	}
+
+	if err != nil {
+		return cacheEntry{}, err
+	}
+
+	raw, err := json.Marshal(f)
+	if err != nil {
+		return cacheEntry{}, err
+	}
+
+	return cacheEntry{feed: f, raw: raw}, nil
+}
+
+// revalidated return the entry expiring after the ttl of the feed from 'now'
+func (e cacheEntry) revalidated(now time.Time) cacheEntry {
+	var m struct {
+		TTL int `json:"ttl"`
+	}
+
+	// the feed was encoded by us, the ttl is always decodable
+	_ = json.Unmarshal(e.raw, &m)
+
+	e.expireAt = now.Add(time.Duration(m.TTL) * time.Second)
+
+	return e
+}
+
+// ==========
+//  OPTIONS
+// ==========
+
+// CachingOptionLanguage specify the language of the feeds fetched by the wrapped client
+// The language is part of the cache key
+func CachingOptionLanguage(lang string) CachingOption {
+	return func(c *CachingClient) {
+		c.language = lang
+	}
+}
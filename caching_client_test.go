@@ -0,0 +1,239 @@
+package gbfs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+// fakeClient return a system_information feed, updated 'age' ago, with the ttl 'ttl'
+// When 'notModified' is true, the refreshes return ErrNotModified
+type fakeClient struct {
+	calls       int32
+	ttl         int
+	age         time.Duration
+	gate        chan struct{}
+	err         error
+	notModified bool
+}
+
+func (c *fakeClient) ForceURLs(map[string]string, bool) {}
+
+func (c *fakeClient) Get(key string, out Feed) error {
+	return c.GetContext(context.Background(), key, out)
+}
+
+func (c *fakeClient) GetContext(ctx context.Context, key string, out Feed) error {
+	atomic.AddInt32(&c.calls, 1)
+
+	if c.gate != nil {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.gate:
+		}
+	}
+
+	if c.err != nil {
+		return c.err
+	}
+
+	si, ok := out.(*gbfsspec.FeedSystemInformation)
+	if !ok {
+		return ErrInvalidFeed
+	}
+
+	si.LastUpdated = gbfsspec.Timestamp(time.Now().Add(-c.age).Unix())
+	si.TTL = c.ttl
+	si.Data.Name = "Bay Wheels"
+
+	return nil
+}
+
+func (c *fakeClient) Refresh(f Feed, force bool) error {
+	return c.RefreshContext(context.Background(), f, force)
+}
+
+func (c *fakeClient) RefreshContext(ctx context.Context, f Feed, force bool) error {
+	if c.notModified {
+		atomic.AddInt32(&c.calls, 1)
+		return ErrNotModified
+	}
+
+	return c.GetContext(ctx, f.FeedKey(), f)
+}
+
+func TestCachingClient_Get(t *testing.T) {
+	fc := &fakeClient{ttl: 300}
+	c := NewCachingClient(fc, CachingOptionLanguage("en"))
+
+	for n := 0; n < 3; n++ {
+		var si gbfsspec.FeedSystemInformation
+		if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+			t.Errorf("expect 'nil' got '%s'", err)
+			t.FailNow()
+		}
+
+		if si.Data.Name != "Bay Wheels" {
+			t.Errorf("expect 'Bay Wheels' got '%s'", si.Data.Name)
+		}
+
+		// each caller receive its own copy
+		si.Data.Name = "modified"
+	}
+
+	if fc.calls != 1 {
+		t.Errorf("expect '1' got '%d'", fc.calls)
+	}
+
+	var si gbfsspec.FeedSystemInformation
+	if err := c.Refresh(&si, true); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+	}
+
+	if fc.calls != 2 {
+		t.Errorf("expect '2' got '%d'", fc.calls)
+	}
+
+	c.ForceURLs(nil, false)
+
+	if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+	}
+
+	if fc.calls != 3 {
+		t.Errorf("expect '3' got '%d'", fc.calls)
+	}
+}
+
+func TestCachingClient_GetExpired(t *testing.T) {
+	fc := &fakeClient{ttl: 0}
+	c := NewCachingClient(fc)
+
+	var si gbfsspec.FeedSystemInformation
+	for n := 0; n < 2; n++ {
+		if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+			t.Errorf("expect 'nil' got '%s'", err)
+		}
+	}
+
+	if fc.calls != 2 {
+		t.Errorf("expect '2' got '%d'", fc.calls)
+	}
+}
+
+func TestCachingClient_GetConcurrent(t *testing.T) {
+	fc := &fakeClient{ttl: 300, gate: make(chan struct{})}
+	c := NewCachingClient(fc)
+
+	var wg sync.WaitGroup
+
+	for n := 0; n < 10; n++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			var si gbfsspec.FeedSystemInformation
+			if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+				t.Errorf("expect 'nil' got '%s'", err)
+			}
+		}()
+	}
+
+	// let the goroutines join the fetch in progress
+	time.Sleep(50 * time.Millisecond)
+	close(fc.gate)
+	wg.Wait()
+
+	if calls := atomic.LoadInt32(&fc.calls); calls != 1 {
+		t.Errorf("expect '1' got '%d'", calls)
+	}
+}
+
+func TestCachingClient_GetConcurrentCanceled(t *testing.T) {
+	fc := &fakeClient{ttl: 300, gate: make(chan struct{})}
+	c := NewCachingClient(fc)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		var si gbfsspec.FeedSystemInformation
+		if err := c.GetContext(ctx, gbfsspec.FeedKeySystemInformation, &si); !errors.Is(err, context.Canceled) {
+			t.Errorf("expect '%s' got '%v'", context.Canceled, err)
+		}
+	}()
+
+	// let the first goroutine start the fetch
+	time.Sleep(20 * time.Millisecond)
+
+	for n := 0; n < 5; n++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			var si gbfsspec.FeedSystemInformation
+			if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+				t.Errorf("expect 'nil' got '%s'", err)
+			}
+		}()
+	}
+
+	// the waiters fetch again once the first fetch is canceled
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	time.Sleep(20 * time.Millisecond)
+	close(fc.gate)
+	wg.Wait()
+
+	if calls := atomic.LoadInt32(&fc.calls); calls != 2 {
+		t.Errorf("expect '2' got '%d'", calls)
+	}
+}
+
+func TestCachingClient_GetNotModified(t *testing.T) {
+	// the feed is expired since a long time, the provider doesn't update it
+	fc := &fakeClient{ttl: 300, age: time.Hour, notModified: true}
+	c := NewCachingClient(fc)
+
+	for n := 0; n < 3; n++ {
+		var si gbfsspec.FeedSystemInformation
+		if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+			t.Errorf("expect 'nil' got '%s'", err)
+		}
+
+		if si.Data.Name != "Bay Wheels" {
+			t.Errorf("expect 'Bay Wheels' got '%s'", si.Data.Name)
+		}
+	}
+
+	// fetched, revalidated, then kept for the ttl
+	if calls := atomic.LoadInt32(&fc.calls); calls != 2 {
+		t.Errorf("expect '2' got '%d'", calls)
+	}
+}
+
+func TestCachingClient_GetError(t *testing.T) {
+	fc := &fakeClient{err: ErrFeedNotExist}
+	c := NewCachingClient(fc)
+
+	var si gbfsspec.FeedSystemInformation
+	if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); !errors.Is(err, ErrFeedNotExist) {
+		t.Errorf("expect '%s' got '%s'", ErrFeedNotExist, err)
+	}
+
+	if err := c.Get(gbfsspec.FeedKeyStationStatus, &si); !errors.Is(err, ErrInvalidFeed) {
+		t.Errorf("expect '%s' got '%s'", ErrInvalidFeed, err)
+	}
+}
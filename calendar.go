@@ -0,0 +1,149 @@
+package gbfs
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+type (
+	// Calendar evaluate the operating seasons of system_calendar in the time zone of the system
+	// The calendars without years repeat every year, and wrap over New Year when they end before they start
+	Calendar struct {
+		calendars []gbfsspec.SystemCalendar
+		loc       *time.Location
+	}
+
+	// season of operation, from the first to the last day included (dates at midnight UTC)
+	season struct {
+		start, end time.Time
+	}
+)
+
+// NewCalendar return the evaluator of the calendars, in the time zone of system_information (e.g. America/New_York)
+func NewCalendar(c *gbfsspec.FeedSystemCalendars, timezone string) (*Calendar, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Calendar{calendars: c.Data.Calendars, loc: loc}, nil
+}
+
+// Calendar return the evaluator of the calendars of the system
+func (s *System) Calendar() (*Calendar, error) {
+	if s.SystemCalendar == nil {
+		return nil, fmt.Errorf("%s: %w", gbfsspec.FeedKeySystemCalendar, ErrFeedNotExist)
+	}
+
+	if s.SystemInformation == nil {
+		return nil, fmt.Errorf("%s: %w", gbfsspec.FeedKeySystemInformation, ErrFeedNotExist)
+	}
+
+	return NewCalendar(s.SystemCalendar, s.SystemInformation.Data.Timezone)
+}
+
+// IsOperating return true when the system operate on the day of the instant, in the time zone of the system
+func (c *Calendar) IsOperating(at time.Time) bool {
+	d := c.date(at)
+
+	for _, s := range c.seasons(d.Year()) {
+		if !d.Before(s.start) && !d.After(s.end) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextSeasonStart return the midnight of the first day of the next season, after the day of the instant
+// False when there is no next season (e.g. the system operate all year long)
+func (c *Calendar) NextSeasonStart(at time.Time) (time.Time, bool) {
+	d := c.date(at)
+
+	for _, s := range c.seasons(d.Year()) {
+		if s.start.After(d) {
+			return time.Date(s.start.Year(), s.start.Month(), s.start.Day(), 0, 0, 0, 0, c.loc), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// date return the day of the instant in the time zone of the system
+func (c *Calendar) date(at time.Time) time.Time {
+	y, m, d := at.In(c.loc).Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// seasons return the merged seasons around the year, the recurring calendars from the year before to two years after
+func (c *Calendar) seasons(year int) []season {
+	var ss []season
+
+	for _, cal := range c.calendars {
+		switch {
+		case cal.StartYear != 0 && cal.EndYear != 0:
+			ss = append(ss, season{
+				start: day(cal.StartYear, cal.StartMonth, cal.StartDay),
+				end:   day(cal.EndYear, cal.EndMonth, cal.EndDay),
+			})
+		case cal.StartYear != 0:
+			ss = append(ss, recurringSeason(cal, cal.StartYear))
+		case cal.EndYear != 0:
+			// the season ending this year start the same year, or the year before when it wraps
+			s := recurringSeason(cal, cal.EndYear)
+			if s.end.Year() != cal.EndYear {
+				s = recurringSeason(cal, cal.EndYear-1)
+			}
+
+			ss = append(ss, s)
+		default:
+			for y := year - 1; y <= year+2; y++ {
+				ss = append(ss, recurringSeason(cal, y))
+			}
+		}
+	}
+
+	return mergeSeasons(ss)
+}
+
+// recurringSeason return the season starting in the year, ending the year after when it wraps over New Year
+func recurringSeason(cal gbfsspec.SystemCalendar, year int) season {
+	s := season{
+		start: day(year, cal.StartMonth, cal.StartDay),
+		end:   day(year, cal.EndMonth, cal.EndDay),
+	}
+
+	if s.end.Before(s.start) {
+		s.end = day(year+1, cal.EndMonth, cal.EndDay)
+	}
+
+	return s
+}
+
+func day(year, month, d int) time.Time {
+	return time.Date(year, time.Month(month), d, 0, 0, 0, 0, time.UTC)
+}
+
+// mergeSeasons sort the seasons and merge the ones overlapping or following each other
+func mergeSeasons(ss []season) []season {
+	sort.Slice(ss, func(a, b int) bool { return ss[a].start.Before(ss[b].start) })
+
+	var merged []season
+
+	for _, s := range ss {
+		if n := len(merged); n > 0 && !s.start.After(merged[n-1].end.AddDate(0, 0, 1)) {
+			if s.end.After(merged[n-1].end) {
+				merged[n-1].end = s.end
+			}
+			continue
+		}
+
+		merged = append(merged, s)
+	}
+
+	return merged
+}
@@ -0,0 +1,118 @@
+package gbfs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+func newTestCalendar(t *testing.T, cc ...gbfsspec.SystemCalendar) *Calendar {
+	c, err := NewCalendar(&gbfsspec.FeedSystemCalendars{Data: gbfsspec.SystemCalendarsData{Calendars: cc}}, "America/Montreal")
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	return c
+}
+
+func TestCalendar_IsOperating(t *testing.T) {
+	// april to november every year, and a winter season wrapping over New Year
+	c := newTestCalendar(t,
+		gbfsspec.SystemCalendar{StartMonth: 4, StartDay: 15, EndMonth: 11, EndDay: 15},
+		gbfsspec.SystemCalendar{StartMonth: 12, StartDay: 20, EndMonth: 1, EndDay: 5},
+	)
+	loc := c.loc
+
+	ii := []struct {
+		at  time.Time
+		out bool
+	}{
+		{at: time.Date(2020, 4, 14, 23, 59, 0, 0, loc), out: false},
+		{at: time.Date(2020, 4, 15, 0, 0, 0, 0, loc), out: true},
+		{at: time.Date(2020, 11, 15, 23, 0, 0, 0, loc), out: true},
+		{at: time.Date(2020, 11, 16, 0, 0, 0, 0, loc), out: false},
+		{at: time.Date(2020, 12, 31, 12, 0, 0, 0, loc), out: true},
+		{at: time.Date(2021, 1, 5, 12, 0, 0, 0, loc), out: true},
+		{at: time.Date(2021, 1, 6, 12, 0, 0, 0, loc), out: false},
+		// 2020-04-15 02:00 UTC is still the 14th in Montreal
+		{at: time.Date(2020, 4, 15, 2, 0, 0, 0, time.UTC), out: false},
+	}
+
+	for _, i := range ii {
+		if o := c.IsOperating(i.at); o != i.out {
+			t.Errorf("expect '%t' got '%t' at '%s'", i.out, o, i.at)
+		}
+	}
+}
+
+func TestCalendar_IsOperatingWithYears(t *testing.T) {
+	c := newTestCalendar(t,
+		gbfsspec.SystemCalendar{StartYear: 2020, StartMonth: 12, StartDay: 1, EndYear: 2021, EndMonth: 2, EndDay: 28},
+	)
+
+	ii := []struct {
+		at  time.Time
+		out bool
+	}{
+		{at: time.Date(2021, 1, 10, 12, 0, 0, 0, c.loc), out: true},
+		{at: time.Date(2021, 12, 10, 12, 0, 0, 0, c.loc), out: false},
+		{at: time.Date(2019, 12, 10, 12, 0, 0, 0, c.loc), out: false},
+	}
+
+	for _, i := range ii {
+		if o := c.IsOperating(i.at); o != i.out {
+			t.Errorf("expect '%t' got '%t' at '%s'", i.out, o, i.at)
+		}
+	}
+}
+
+func TestCalendar_NextSeasonStart(t *testing.T) {
+	c := newTestCalendar(t,
+		gbfsspec.SystemCalendar{StartMonth: 4, StartDay: 15, EndMonth: 11, EndDay: 15},
+		gbfsspec.SystemCalendar{StartMonth: 12, StartDay: 20, EndMonth: 1, EndDay: 5},
+	)
+	loc := c.loc
+
+	ii := []struct {
+		at, out time.Time
+	}{
+		{at: time.Date(2020, 2, 1, 12, 0, 0, 0, loc), out: time.Date(2020, 4, 15, 0, 0, 0, 0, loc)},
+		{at: time.Date(2020, 6, 1, 12, 0, 0, 0, loc), out: time.Date(2020, 12, 20, 0, 0, 0, 0, loc)},
+		{at: time.Date(2020, 12, 25, 12, 0, 0, 0, loc), out: time.Date(2021, 4, 15, 0, 0, 0, 0, loc)},
+	}
+
+	for _, i := range ii {
+		if o, ok := c.NextSeasonStart(i.at); !ok || !o.Equal(i.out) {
+			t.Errorf("expect '%s' got '%s'", i.out, o)
+		}
+	}
+
+	// all year long
+	c = newTestCalendar(t, gbfsspec.SystemCalendar{StartMonth: 1, StartDay: 1, EndMonth: 12, EndDay: 31})
+
+	if o, ok := c.NextSeasonStart(time.Date(2020, 6, 1, 12, 0, 0, 0, loc)); ok {
+		t.Errorf("expect 'false' got '%s'", o)
+	}
+
+	if !c.IsOperating(time.Date(2020, 12, 31, 23, 0, 0, 0, loc)) {
+		t.Errorf("expect 'true' got 'false'")
+	}
+}
+
+func TestSystem_Calendar(t *testing.T) {
+	s := &System{SystemInformation: &gbfsspec.FeedSystemInformation{}}
+
+	if _, err := s.Calendar(); !errors.Is(err, ErrFeedNotExist) {
+		t.Errorf("expect '%s' got '%v'", ErrFeedNotExist, err)
+	}
+
+	s.SystemCalendar = &gbfsspec.FeedSystemCalendars{}
+	s.SystemInformation.Data.Timezone = "UTC"
+
+	if _, err := s.Calendar(); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+	}
+}
@@ -0,0 +1,62 @@
+package gbfs
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+// decodeWarner is implemented by the feeds who keep the warnings of the decoding (see gbfsspec.Metadata)
+type decodeWarner interface {
+	DecodeWarnings() []gbfsspec.DecodeWarning
+	SetDecodeWarnings(ww []gbfsspec.DecodeWarning)
+}
+
+// decodeFeed decode 'raw' in 'out'
+// When 'lenient' is true, the values are converted to the type of their field before decoding,
+// when 'strict' is true, the unknown fields and the required fields absent are reported.
+// The warnings are attached to 'out' when it implements decodeWarner.
+func decodeFeed(raw []byte, out interface{}, strict, lenient bool) error {
+	var doc interface{}
+
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+
+	if err := d.Decode(&doc); err != nil {
+		return err
+	}
+
+	t := reflect.TypeOf(out)
+
+	var ww []gbfsspec.DecodeWarning
+
+	if lenient {
+		doc, _ = coerce("", doc, t, &ww)
+
+		var err error
+		if raw, err = json.Marshal(doc); err != nil {
+			return err
+		}
+	}
+
+	if err := json.Unmarshal(raw, out); err != nil {
+		return err
+	}
+
+	if strict {
+		var key string
+		if f, ok := out.(Feed); ok {
+			key = f.FeedKey()
+		}
+
+		ww = append(ww, strictWarnings(doc, t, key)...)
+	}
+
+	if w, ok := out.(decodeWarner); ok {
+		w.SetDecodeWarnings(ww)
+	}
+
+	return nil
+}
@@ -1,16 +1,83 @@
 package gbfs
 
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
 // Error type
 type Error string
 
 // List of errors the gbfs.Client can return
 const (
-	ErrBaseURLMissing Error = "base url is missing"
-	ErrFeedNotExist   Error = "feed not exist"
-	ErrInvalidFeed    Error = "invalid feed"
+	ErrAutoDiscoveryMissing Error = "auto-discovery url is missing"
+	ErrBaseURLMissing       Error = "base url is missing"
+	ErrFeedNotExist         Error = "feed not exist"
+	ErrInvalidFeed          Error = "invalid feed"
+	ErrLanguageNotExist     Error = "language not exist"
+	ErrNotModified          Error = "feed not modified"
+	ErrVersionNotSupported  Error = "version not supported"
+)
+
+// Maximum length of the response body kept in HTTPError
+const httpErrorBodyLimit = 512
+
+type (
+	// HTTPError is returned when the provider respond with an unexpected status code
+	// A 404 HTTPError match ErrFeedNotExist with errors.Is
+	HTTPError struct {
+		// Key of the requested feed
+		FeedKey string
+
+		// URL requested
+		URL string
+
+		// Status code of the response
+		StatusCode int
+
+		// Delay requested by the provider through the Retry-After header, 0 when absent
+		RetryAfter time.Duration
+
+		// Beginning of the response body, truncated to 512 bytes
+		Body string
+	}
 )
 
 // Error return the error formatted in string
 func (e Error) Error() string {
 	return string(e)
 }
+
+// Error return the error formatted in string
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("invalid status code (%d) for feed '%s' (%s)", e.StatusCode, e.FeedKey, e.URL)
+}
+
+// Is return true for ErrFeedNotExist when the status code is 404
+func (e *HTTPError) Is(target error) bool {
+	return target == ErrFeedNotExist && e.StatusCode == http.StatusNotFound
+}
+
+// parseRetryAfter parse the Retry-After header, who can be either a number of seconds or a HTTP date
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	if v == "" {
+		return 0
+	}
+
+	if s, err := strconv.Atoi(v); err == nil {
+		if s < 0 {
+			return 0
+		}
+
+		return time.Duration(s) * time.Second
+	}
+
+	t, err := http.ParseTime(v)
+	if err != nil || t.Before(now) {
+		return 0
+	}
+
+	return t.Sub(now)
+}
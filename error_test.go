@@ -1,9 +1,61 @@
 package gbfs
 
-import "testing"
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
 
 func TestError_Error(t *testing.T) {
 	if ErrInvalidFeed.Error() != "invalid feed" {
 		t.Errorf("expect 'invalid feed' got '%s'", ErrInvalidFeed.Error())
 	}
 }
+
+func TestHTTPError_Is(t *testing.T) {
+	ii := []struct {
+		in  *HTTPError
+		out bool
+	}{
+		{in: &HTTPError{StatusCode: http.StatusNotFound}, out: true},
+		{in: &HTTPError{StatusCode: http.StatusServiceUnavailable}, out: false},
+	}
+
+	for _, i := range ii {
+		if got := errors.Is(i.in, ErrFeedNotExist); got != i.out {
+			t.Errorf("expect '%t' got '%t' for '%d'", i.out, got, i.in.StatusCode)
+		}
+	}
+}
+
+func TestHTTPError_Error(t *testing.T) {
+	err := &HTTPError{FeedKey: "station_status", URL: "https://domain.tld/station_status.json", StatusCode: 503}
+	want := "invalid status code (503) for feed 'station_status' (https://domain.tld/station_status.json)"
+
+	if err.Error() != want {
+		t.Errorf("expect '%s' got '%s'", want, err.Error())
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2020, 5, 11, 20, 0, 0, 0, time.UTC)
+
+	ii := []struct {
+		in  string
+		out time.Duration
+	}{
+		{in: "", out: 0},
+		{in: "120", out: 2 * time.Minute},
+		{in: "-1", out: 0},
+		{in: "Mon, 11 May 2020 20:00:30 GMT", out: 30 * time.Second},
+		{in: "Mon, 11 May 2020 19:00:00 GMT", out: 0},
+		{in: "nope", out: 0},
+	}
+
+	for _, i := range ii {
+		if got := parseRetryAfter(i.in, now); got != i.out {
+			t.Errorf("expect '%s' got '%s' for '%s'", i.out, got, i.in)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package gbfs
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+const (
+	// Number of days after the instant evaluated where the next opening or closing is searched
+	hoursLookahead = 8
+
+	// Longest gap between the end of an interval and the start of the next one considered as continuous,
+	// the end times are often written as the last second of the day (e.g. 00:00:00 to 23:59:59)
+	hoursMaxGap = time.Second
+)
+
+type (
+	// Hours evaluate the rental hours of system_hours in the time zone of the system
+	Hours struct {
+		hours []gbfsspec.SystemHoursRentalHours
+		loc   *time.Location
+	}
+
+	// interval of opening [start, end)
+	interval struct {
+		start, end time.Time
+	}
+)
+
+var weekdays = map[time.Weekday]gbfsspec.Day{
+	time.Monday:    gbfsspec.DayMonday,
+	time.Tuesday:   gbfsspec.DayTuesday,
+	time.Wednesday: gbfsspec.DayWednesday,
+	time.Thursday:  gbfsspec.DayThursday,
+	time.Friday:    gbfsspec.DayFriday,
+	time.Saturday:  gbfsspec.DaySaturday,
+	time.Sunday:    gbfsspec.DaySunday,
+}
+
+// NewHours return the evaluator of the rental hours, in the time zone of system_information (e.g. America/New_York)
+func NewHours(h *gbfsspec.FeedSystemHours, timezone string) (*Hours, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Hours{hours: h.Data.RentalHours, loc: loc}, nil
+}
+
+// Hours return the evaluator of the rental hours of the system
+func (s *System) Hours() (*Hours, error) {
+	if s.SystemHours == nil {
+		return nil, fmt.Errorf("%s: %w", gbfsspec.FeedKeySystemHours, ErrFeedNotExist)
+	}
+
+	if s.SystemInformation == nil {
+		return nil, fmt.Errorf("%s: %w", gbfsspec.FeedKeySystemInformation, ErrFeedNotExist)
+	}
+
+	return NewHours(s.SystemHours, s.SystemInformation.Data.Timezone)
+}
+
+// IsOpen return true when the system is open at the instant for the user type (any user type when empty)
+func (h *Hours) IsOpen(at time.Time, u gbfsspec.UserType) bool {
+	for _, i := range h.intervals(at, u) {
+		if !at.Before(i.start) && at.Before(i.end) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextOpening return the next time the system open after the instant (excluded), false when it doesn't open
+// again within a week
+func (h *Hours) NextOpening(at time.Time, u gbfsspec.UserType) (time.Time, bool) {
+	for _, i := range h.intervals(at, u) {
+		if i.start.After(at) {
+			return i.start, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// NextClosing return the next time the system close after the instant (excluded), false when it doesn't close
+// within a week (e.g. open 24/7)
+func (h *Hours) NextClosing(at time.Time, u gbfsspec.UserType) (time.Time, bool) {
+	horizon := h.horizon(at)
+
+	for _, i := range h.intervals(at, u) {
+		// an interval ending at the horizon is cut by the lookahead
+		if i.end.After(at) && i.end.Before(horizon) {
+			return i.end, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// horizon return the end of the days evaluated
+func (h *Hours) horizon(at time.Time) time.Time {
+	y, m, d := at.In(h.loc).Date()
+
+	return time.Date(y, m, d+hoursLookahead, 0, 0, 0, 0, h.loc)
+}
+
+// intervals return the merged intervals of opening from the day before the instant to the lookahead,
+// the end times past midnight (e.g. 26:00:00) end the next day
+// The day of the horizon is included, to merge the intervals continuing after the horizon
+func (h *Hours) intervals(at time.Time, u gbfsspec.UserType) []interval {
+	y, m, d := at.In(h.loc).Date()
+	horizon := h.horizon(at)
+
+	var ii []interval
+
+	for day := -1; day <= hoursLookahead; day++ {
+		date := time.Date(y, m, d+day, 0, 0, 0, 0, h.loc)
+
+		for _, r := range h.hours {
+			if !hasUserType(r.UserTypes, u) || !hasDay(r.Days, weekdays[date.Weekday()]) {
+				continue
+			}
+
+			sh, sm, ss := r.StartTime.Clock()
+			eh, em, es := r.EndTime.Clock()
+
+			start := time.Date(y, m, d+day, sh, sm, ss, 0, h.loc)
+			end := time.Date(y, m, d+day, eh, em, es, 0, h.loc)
+
+			// tolerate the end times after midnight written as the next day (e.g. 22:00:00 to 02:00:00)
+			if !end.After(start) {
+				end = time.Date(y, m, d+day+1, eh, em, es, 0, h.loc)
+			}
+
+			if start.After(horizon) {
+				continue
+			}
+
+			if end.After(horizon) {
+				end = horizon
+			}
+
+			ii = append(ii, interval{start: start, end: end})
+		}
+	}
+
+	return mergeIntervals(ii)
+}
+
+// hasUserType return true when 'u' is in the list, or when 'u' or the list is empty
+func hasUserType(uu []gbfsspec.UserType, u gbfsspec.UserType) bool {
+	if u == "" || len(uu) == 0 {
+		return true
+	}
+
+	for _, v := range uu {
+		if v == u {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasDay(dd []gbfsspec.Day, d gbfsspec.Day) bool {
+	for _, v := range dd {
+		if v == d {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mergeIntervals sort the intervals and merge the ones overlapping or adjacent (see hoursMaxGap)
+func mergeIntervals(ii []interval) []interval {
+	sort.Slice(ii, func(a, b int) bool { return ii[a].start.Before(ii[b].start) })
+
+	var merged []interval
+
+	for _, i := range ii {
+		if n := len(merged); n > 0 && !i.start.After(merged[n-1].end.Add(hoursMaxGap)) {
+			if i.end.After(merged[n-1].end) {
+				merged[n-1].end = i.end
+			}
+			continue
+		}
+
+		merged = append(merged, i)
+	}
+
+	return merged
+}
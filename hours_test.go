@@ -0,0 +1,160 @@
+package gbfs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+func newTestHours(t *testing.T) (*Hours, *time.Location) {
+	h, err := NewHours(&gbfsspec.FeedSystemHours{Data: gbfsspec.SystemHoursData{RentalHours: []gbfsspec.SystemHoursRentalHours{
+		{
+			UserTypes: []gbfsspec.UserType{gbfsspec.UserTypeMember},
+			Days:      []gbfsspec.Day{"mon", "tue", "wed", "thu", "fri"},
+			StartTime: "06:00:00",
+			EndTime:   "26:00:00",
+		},
+		{
+			UserTypes: []gbfsspec.UserType{gbfsspec.UserTypeMember},
+			Days:      []gbfsspec.Day{"sat", "sun"},
+			StartTime: "08:00:00",
+			EndTime:   "20:00:00",
+		},
+		{
+			UserTypes: []gbfsspec.UserType{gbfsspec.UserTypeNonMember},
+			Days:      []gbfsspec.Day{"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+			StartTime: "00:00:00",
+			EndTime:   "24:00:00",
+		},
+	}}}, "America/Los_Angeles")
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	return h, h.loc
+}
+
+func TestHours_IsOpen(t *testing.T) {
+	h, loc := newTestHours(t)
+
+	// 2020-05-11 is a monday
+	ii := []struct {
+		at   time.Time
+		user gbfsspec.UserType
+		out  bool
+	}{
+		{at: time.Date(2020, 5, 11, 5, 0, 0, 0, loc), user: gbfsspec.UserTypeMember, out: false},
+		{at: time.Date(2020, 5, 11, 6, 0, 0, 0, loc), user: gbfsspec.UserTypeMember, out: true},
+		{at: time.Date(2020, 5, 12, 1, 59, 59, 0, loc), user: gbfsspec.UserTypeMember, out: true},
+		{at: time.Date(2020, 5, 12, 2, 0, 0, 0, loc), user: gbfsspec.UserTypeMember, out: false},
+		// past midnight of friday
+		{at: time.Date(2020, 5, 16, 1, 0, 0, 0, loc), user: gbfsspec.UserTypeMember, out: true},
+		{at: time.Date(2020, 5, 16, 3, 0, 0, 0, loc), user: gbfsspec.UserTypeMember, out: false},
+		{at: time.Date(2020, 5, 11, 5, 0, 0, 0, loc), user: gbfsspec.UserTypeNonMember, out: true},
+		{at: time.Date(2020, 5, 11, 5, 0, 0, 0, loc), user: "", out: true},
+		// same instant in UTC
+		{at: time.Date(2020, 5, 11, 12, 0, 0, 0, time.UTC), user: gbfsspec.UserTypeMember, out: false},
+	}
+
+	for _, i := range ii {
+		if o := h.IsOpen(i.at, i.user); o != i.out {
+			t.Errorf("expect '%t' got '%t' at '%s' for '%s'", i.out, o, i.at, i.user)
+		}
+	}
+}
+
+func TestHours_NextOpeningClosing(t *testing.T) {
+	h, loc := newTestHours(t)
+
+	ii := []struct {
+		at               time.Time
+		user             gbfsspec.UserType
+		opening, closing time.Time
+	}{
+		{
+			at: time.Date(2020, 5, 11, 5, 0, 0, 0, loc), user: gbfsspec.UserTypeMember,
+			opening: time.Date(2020, 5, 11, 6, 0, 0, 0, loc), closing: time.Date(2020, 5, 12, 2, 0, 0, 0, loc),
+		},
+		{
+			at: time.Date(2020, 5, 12, 1, 0, 0, 0, loc), user: gbfsspec.UserTypeMember,
+			opening: time.Date(2020, 5, 12, 6, 0, 0, 0, loc), closing: time.Date(2020, 5, 12, 2, 0, 0, 0, loc),
+		},
+		{
+			at: time.Date(2020, 5, 17, 21, 0, 0, 0, loc), user: gbfsspec.UserTypeMember,
+			opening: time.Date(2020, 5, 18, 6, 0, 0, 0, loc), closing: time.Date(2020, 5, 19, 2, 0, 0, 0, loc),
+		},
+	}
+
+	for _, i := range ii {
+		if o, ok := h.NextOpening(i.at, i.user); !ok || !o.Equal(i.opening) {
+			t.Errorf("expect '%s' got '%s'", i.opening, o)
+		}
+
+		if c, ok := h.NextClosing(i.at, i.user); !ok || !c.Equal(i.closing) {
+			t.Errorf("expect '%s' got '%s'", i.closing, c)
+		}
+	}
+
+	// always open
+	at := time.Date(2020, 5, 11, 5, 0, 0, 0, loc)
+
+	if _, ok := h.NextClosing(at, gbfsspec.UserTypeNonMember); ok {
+		t.Errorf("expect 'false' got 'true'")
+	}
+
+	if _, ok := h.NextOpening(at, gbfsspec.UserTypeNonMember); ok {
+		t.Errorf("expect 'false' got 'true'")
+	}
+}
+
+func TestSystem_Hours(t *testing.T) {
+	s := &System{SystemInformation: &gbfsspec.FeedSystemInformation{}}
+
+	if _, err := s.Hours(); !errors.Is(err, ErrFeedNotExist) {
+		t.Errorf("expect '%s' got '%v'", ErrFeedNotExist, err)
+	}
+
+	s.SystemHours = &gbfsspec.FeedSystemHours{}
+	s.SystemInformation.Data.Timezone = "Mars/Olympus_Mons"
+
+	if _, err := s.Hours(); err == nil {
+		t.Errorf("expect error got 'nil'")
+	}
+}
+
+func TestHours_endOfDay(t *testing.T) {
+	// open 24/7, with the end time written as the last second of the day
+	h, err := NewHours(&gbfsspec.FeedSystemHours{Data: gbfsspec.SystemHoursData{RentalHours: []gbfsspec.SystemHoursRentalHours{
+		{
+			UserTypes: []gbfsspec.UserType{gbfsspec.UserTypeMember, gbfsspec.UserTypeNonMember},
+			Days:      []gbfsspec.Day{"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+			StartTime: "00:00:00",
+			EndTime:   "23:59:59",
+		},
+	}}}, "America/Los_Angeles")
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	for _, at := range []time.Time{
+		time.Date(2020, 5, 11, 12, 0, 0, 0, h.loc),
+		time.Date(2020, 5, 11, 23, 59, 59, 500000000, h.loc),
+		time.Date(2020, 5, 12, 0, 0, 0, 0, h.loc),
+	} {
+		if !h.IsOpen(at, "") {
+			t.Errorf("expect 'true' got 'false' at '%s'", at)
+		}
+
+		if c, ok := h.NextClosing(at, ""); ok {
+			t.Errorf("expect 'false' got '%s' at '%s'", c, at)
+		}
+
+		if o, ok := h.NextOpening(at, ""); ok {
+			t.Errorf("expect 'false' got '%s' at '%s'", o, at)
+		}
+	}
+}
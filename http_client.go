@@ -1,12 +1,39 @@
 package gbfs
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
 )
 
+// Minimum interval between two fetches of the auto-discovery feed, even when expired
+// A TTL of 0 (or a last_updated never updated) would fetch it before each feed
+const discoveryMinInterval = time.Minute
+
 type (
+	// discoveryCall is the fetch of the auto-discovery feed in progress, shared by the concurrent callers
+	discoveryCall struct {
+		done chan struct{}
+		err  error
+	}
+
+	validator struct {
+		etag         string
+		lastModified string
+
+		// last_updated + ttl of the response, to recognize the feed decoded from it
+		expireAt time.Time
+	}
+
 	// HTTPClient use HTTP protocol to fetch feeds
 	HTTPClient struct {
 		client http.Client
@@ -14,21 +41,54 @@ type (
 		baseURL  string
 		urls     map[string]string
 		language string
+
+		autoDiscoveryURL string
+		discovery        *discoveryFeed
+		discovered       map[string]string
+		discovering      *discoveryCall
+
+		// last fetch of the auto-discovery feed, failed or not
+		discoveredAt time.Time
+
+		// minimum interval between two fetches of the auto-discovery feed, see discoveryMinInterval
+		discoveryInterval time.Duration
+
+		// Version negotiation, see HTTPOptionVersionNegotiation
+		negotiation bool
+		preferences []string
+		version     string
+
+		retry RetryPolicy
+
+		// ETag and Last-Modified of the last response by feed key, nil when conditional requests are disabled
+		validators map[string]validator
+
+		// Report the unknown and missing fields, see HTTPOptionStrictDecoding
+		strict bool
+
+		// Convert the values to the type of the fields, see HTTPOptionLenientDecoding
+		lenient bool
+
+		mu sync.Mutex
 	}
 )
 
 // NewHTTPClient return a gbfs.Client will use http to fetch feeds
 func NewHTTPClient(opts ...HTTPOption) (Client, error) {
-	c := &HTTPClient{urls: make(map[string]string)}
+	c := &HTTPClient{urls: make(map[string]string), discoveryInterval: discoveryMinInterval}
 
 	for _, opt := range opts {
 		opt(c)
 	}
 
-	if c.baseURL == "" {
+	if c.baseURL == "" && c.autoDiscoveryURL == "" {
 		return nil, ErrBaseURLMissing
 	}
 
+	if c.negotiation && c.autoDiscoveryURL == "" {
+		return nil, ErrAutoDiscoveryMissing
+	}
+
 	return c, nil
 }
 
@@ -36,6 +96,14 @@ func NewHTTPClient(opts ...HTTPOption) (Client, error) {
 // Set replace to true when you want to clean previous build URL
 // Useful for provider that doesn't respect the standard for the URL
 func (c *HTTPClient) ForceURLs(urls map[string]string, replace bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// the validators were sent by the previous URLs
+	if c.validators != nil {
+		c.validators = make(map[string]validator)
+	}
+
 	if replace {
 		c.urls = urls
 		return
@@ -48,13 +116,90 @@ func (c *HTTPClient) ForceURLs(urls map[string]string, replace bool) {
 
 // Get one feed and try to decode the response in 'out' structure
 func (c *HTTPClient) Get(key string, out Feed) error {
+	return c.GetContext(context.Background(), key, out)
+}
+
+// GetContext get one feed and try to decode the response in 'out' structure
+// The context is attached to the HTTP request, cancel it to abort the fetch
+func (c *HTTPClient) GetContext(ctx context.Context, key string, out Feed) error {
 	if out.FeedKey() != key {
 		return ErrInvalidFeed
 	}
 
-	req, err := http.NewRequest(http.MethodGet, c.url(key), nil)
+	return c.get(ctx, key, out, false)
+}
+
+// Refresh the feed when is expired or forced (via 'forceRefresh')
+func (c *HTTPClient) Refresh(f Feed, forceRefresh bool) error {
+	return c.RefreshContext(context.Background(), f, forceRefresh)
+}
+
+// RefreshContext refresh the feed when is expired or forced (via 'forceRefresh')
+// The context is attached to the HTTP request, cancel it to abort the fetch
+// The content of the feed is replaced by a new structure, the previous one is never written in
+func (c *HTTPClient) RefreshContext(ctx context.Context, f Feed, forceRefresh bool) error {
+	// not forced and feed not expired
+	if !forceRefresh && !f.IsExpired() {
+		return nil
+	}
+
+	return c.get(ctx, f.FeedKey(), f, true)
+}
+
+// get resolve the URL of the feed and fetch it
+// 'refresh' is true when 'out' may hold a previous version of the feed (see fetch)
+func (c *HTTPClient) get(ctx context.Context, key string, out Feed, refresh bool) error {
+	u, err := c.resolveURL(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return c.fetchWithRetry(ctx, key, u, out, refresh)
+}
+
+// fetchWithRetry fetch the feed, and retry according to the retry policy when the error is transient
+func (c *HTTPClient) fetchWithRetry(ctx context.Context, key, u string, out interface{}, refresh bool) error {
+	for attempt := 1; ; attempt++ {
+		err := c.fetch(ctx, key, u, out, refresh)
+		if err == nil || attempt >= c.retry.MaxAttempts || !isRetryable(err) {
+			return err
+		}
+
+		d, ok := c.retry.backoff(attempt, retryAfter(err))
+		if !ok {
+			return err
+		}
+
+		// the context error is kept for errors.Is, with the error of the last attempt
+		if serr := sleep(ctx, d); serr != nil {
+			return fmt.Errorf("%s: %w", err, serr)
+		}
+	}
+}
+
+// fetch the feed and decode it in 'out'
+// When 'refresh' is true, 'out' may hold a previous version of the feed: it's replaced by a new structure,
+// and when conditional requests are enabled the validators of the last response are sent if 'out' was decoded
+// from this response (same last_updated and ttl)
+func (c *HTTPClient) fetch(ctx context.Context, key, u string, out interface{}, refresh bool) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return fmt.Errorf("http.NewRequest: %w", err)
+		return fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+
+	var conditional bool
+
+	if refresh {
+		var v validator
+		if v, conditional = c.validator(key, out); conditional {
+			if v.etag != "" {
+				req.Header.Set("If-None-Match", v.etag)
+			}
+
+			if v.lastModified != "" {
+				req.Header.Set("If-Modified-Since", v.lastModified)
+			}
+		}
 	}
 
 	res, err := c.client.Do(req)
@@ -63,29 +208,244 @@ func (c *HTTPClient) Get(key string, out Feed) error {
 	}
 	defer func() { _ = res.Body.Close() }()
 
+	if conditional && res.StatusCode == http.StatusNotModified {
+		return ErrNotModified
+	}
+
 	if res.StatusCode != http.StatusOK {
-		switch res.StatusCode {
-		case http.StatusNotFound:
-			return ErrFeedNotExist
-		default:
-			return fmt.Errorf("invalid status code (%d)", res.StatusCode)
+		body, _ := ioutil.ReadAll(io.LimitReader(res.Body, httpErrorBodyLimit))
+
+		return &HTTPError{
+			FeedKey:    key,
+			URL:        u,
+			StatusCode: res.StatusCode,
+			RetryAfter: parseRetryAfter(res.Header.Get("Retry-After"), time.Now()),
+			Body:       string(body),
 		}
 	}
 
-	return json.NewDecoder(res.Body).Decode(out)
+	// 'out' hold the previous version, decode in a new structure to not keep (or write in) its fields
+	if refresh {
+		err = c.decodeNew(res.Body, out.(Feed))
+	} else {
+		err = c.decode(res.Body, out)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	c.setValidator(key, out, res.Header)
+
+	return nil
 }
 
-// Refresh the feed when is expired or forced (via 'forceRefresh')
-func (c *HTTPClient) Refresh(f Feed, forceRefresh bool) error {
-	// not forced and feed not expired
-	if !forceRefresh && !f.IsExpired() {
-		return nil
+// validator return the validators to send for the feed 'out', false when 'out' wasn't decoded from the last response
+func (c *HTTPClient) validator(key string, out interface{}) (validator, bool) {
+	f, ok := out.(Feed)
+	if !ok {
+		return validator{}, false
+	}
+
+	exp, ok := expireAt(f)
+	if !ok {
+		return validator{}, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v, ok := c.validators[key]
+
+	return v, ok && v.expireAt.Equal(exp)
+}
+
+// setValidator keep the validators of the response, when conditional requests are enabled
+func (c *HTTPClient) setValidator(key string, out interface{}, h http.Header) {
+	f, ok := out.(Feed)
+	if !ok {
+		return
+	}
+
+	exp, ok := expireAt(f)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.validators == nil {
+		return
+	}
+
+	c.validators[key] = validator{
+		etag:         h.Get("ETag"),
+		lastModified: h.Get("Last-Modified"),
+		expireAt:     exp,
+	}
+}
+
+// decodeNew decode the body in a new structure of the type of 'out', and replace 'out' by it
+func (c *HTTPClient) decodeNew(body io.Reader, out Feed) error {
+	f, err := newFeed(out)
+	if err != nil {
+		return err
 	}
 
-	return c.Get(f.FeedKey(), f)
+	if err := c.decode(body, f); err != nil {
+		return err
+	}
+
+	reflect.ValueOf(out).Elem().Set(reflect.ValueOf(f).Elem())
+
+	return nil
+}
+
+// decode the body in 'out', strictly or leniently when enabled
+func (c *HTTPClient) decode(body io.Reader, out interface{}) error {
+	if !c.strict && !c.lenient {
+		return json.NewDecoder(body).Decode(out)
+	}
+
+	raw, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	return decodeFeed(raw, out, c.strict, c.lenient)
+}
+
+// resolveURL return the URL of the feed, from the auto-discovery feed when enabled
+func (c *HTTPClient) resolveURL(ctx context.Context, key string) (string, error) {
+	if c.autoDiscovery() == "" {
+		return c.url(key), nil
+	}
+
+	if err := c.discover(ctx); err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if key == gbfsspec.FeedKeyAutoDiscovery {
+		return c.autoDiscoveryURL, nil
+	}
+
+	if u, ok := c.urls[key]; ok {
+		return u, nil
+	}
+
+	if u, ok := c.discovered[key]; ok {
+		return u, nil
+	}
+
+	// the feed is not listed by the provider
+	return "", ErrFeedNotExist
+}
+
+// discover fetch the auto-discovery feed when never fetched or expired (at most once by discoveryMinInterval),
+// and keep the URLs listed for the language of the client
+// When the feed can't be fetched again, the URLs already discovered are kept and no error is returned.
+// The version is negotiated before the first fetch when enabled. Concurrent callers share the same fetch.
+func (c *HTTPClient) discover(ctx context.Context) error {
+	for {
+		c.mu.Lock()
+
+		if c.discovery != nil && (!c.discovery.IsExpired() || time.Since(c.discoveredAt) < c.discoveryInterval) {
+			c.mu.Unlock()
+			return nil
+		}
+
+		if call := c.discovering; call != nil {
+			c.mu.Unlock()
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-call.done:
+			}
+
+			// the fetch was aborted by the context of another caller, try again with ours
+			if isContextError(call.err) && ctx.Err() == nil {
+				continue
+			}
+
+			return call.err
+		}
+
+		call := &discoveryCall{done: make(chan struct{})}
+		c.discovering = call
+		negotiate := c.negotiation && c.version == ""
+		u := c.autoDiscoveryURL
+		c.mu.Unlock()
+
+		err := c.fetchDiscovery(ctx, u, negotiate)
+
+		c.mu.Lock()
+		c.discovering = nil
+
+		// the URLs already discovered are still served, the fetch is tried again after the minimum interval
+		if err != nil && c.discovery != nil && !isContextError(err) {
+			c.discoveredAt = time.Now()
+			err = nil
+		}
+		c.mu.Unlock()
+
+		call.err = err
+
+		close(call.done)
+
+		return call.err
+	}
+}
+
+// fetchDiscovery fetch the auto-discovery feed at 'u', negotiating the version before when 'negotiate' is true,
+// and keep the URLs listed for the language of the client
+func (c *HTTPClient) fetchDiscovery(ctx context.Context, u string, negotiate bool) error {
+	var f *discoveryFeed
+
+	if negotiate {
+		var err error
+		if f, u, err = c.negotiate(ctx, u); err != nil {
+			return fmt.Errorf("version negotiation: %w", err)
+		}
+	}
+
+	if f == nil {
+		f = &discoveryFeed{}
+		if err := c.fetchWithRetry(ctx, gbfsspec.FeedKeyAutoDiscovery, u, f, false); err != nil {
+			return fmt.Errorf("auto-discovery: %w", err)
+		}
+	}
+
+	urls, err := f.urls(c.language)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.discovery = f
+	c.discovered = urls
+	c.discoveredAt = time.Now()
+	c.mu.Unlock()
+
+	return nil
+}
+
+// autoDiscovery return the URL of the auto-discovery feed, switched by the version negotiation
+func (c *HTTPClient) autoDiscovery() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.autoDiscoveryURL
 }
 
 func (c *HTTPClient) url(key string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if u, ok := c.urls[key]; ok {
 		return u
 	}
@@ -101,6 +461,32 @@ func (c *HTTPClient) url(key string) string {
 	return c.urls[key]
 }
 
+// pickLanguage return the feeds listed for the language 'lang',
+// or the first language (in alphabetical order) when 'lang' is empty
+func pickLanguage(d gbfsspec.GBFSData, lang string) (gbfsspec.GBFSLanguage, error) {
+	if lang != "" {
+		l, ok := d.Languages[lang]
+		if !ok {
+			return gbfsspec.GBFSLanguage{}, ErrLanguageNotExist
+		}
+
+		return l, nil
+	}
+
+	ll := make([]string, 0, len(d.Languages))
+	for l := range d.Languages {
+		ll = append(ll, l)
+	}
+
+	if len(ll) == 0 {
+		return gbfsspec.GBFSLanguage{}, ErrLanguageNotExist
+	}
+
+	sort.Strings(ll)
+
+	return d.Languages[ll[0]], nil
+}
+
 // ==========
 //  OPTIONS
 // ==========
@@ -120,7 +506,7 @@ func HTTPOptionBaseURL(url string) HTTPOption {
 }
 
 // HTTPOptionLanguage specify the language of the feed
-// Used to determined the path of the feed
+// Used to determined the path of the feed, or the feeds to use from the auto-discovery feed
 func HTTPOptionLanguage(lang string) HTTPOption {
 	return func(c *HTTPClient) {
 		c.language = lang
@@ -133,3 +519,52 @@ func HTTPOptionForceURL(key, url string) HTTPOption {
 		c.urls[key] = url
 	}
 }
+
+// HTTPOptionAutoDiscovery specify the URL of the auto-discovery feed (gbfs.json)
+// The URLs of the others feeds are read from it, for the language specified with HTTPOptionLanguage
+// (or the first one when no language is specified). The auto-discovery feed is fetched again once its TTL expires,
+// at most once a minute, the URLs already discovered are kept when it fails.
+// URLs specified with HTTPOptionForceURL or ForceURLs take precedence over the discovered ones.
+func HTTPOptionAutoDiscovery(url string) HTTPOption {
+	return func(c *HTTPClient) {
+		c.autoDiscoveryURL = url
+	}
+}
+
+// HTTPOptionRetry specify the policy used to retry a failed fetch (see DefaultRetryPolicy)
+// By default a fetch is never retried
+func HTTPOptionRetry(p RetryPolicy) HTTPOption {
+	return func(c *HTTPClient) {
+		c.retry = p
+	}
+}
+
+// HTTPOptionConditionalRequests enable the conditional requests
+// On Refresh, the ETag and Last-Modified headers of the last response of the feed are sent back (If-None-Match and
+// If-Modified-Since) when the refreshed feed was decoded from this response. When the provider respond
+// 304 Not Modified the feed is left untouched and ErrNotModified is returned. Get always fetch the whole feed.
+// The Poller refresh the last version delivered, a version not modified is not delivered again.
+func HTTPOptionConditionalRequests() HTTPOption {
+	return func(c *HTTPClient) {
+		c.validators = make(map[string]validator)
+	}
+}
+
+// HTTPOptionStrictDecoding report the differences between the JSON of the feeds and their structure
+// The unknown fields (likely extensions of the spec or typos) and the required fields absent are attached
+// as warnings to the feed (see gbfsspec.Metadata.DecodeWarnings), the feed is decoded as usual
+func HTTPOptionStrictDecoding() HTTPOption {
+	return func(c *HTTPClient) {
+		c.strict = true
+	}
+}
+
+// HTTPOptionLenientDecoding convert the values who don't have the type of their field, instead of failing the fetch
+// Numbers sent as strings ("45.5"), floats for integers (a timestamp 1600000000.0 is truncated) and numbers for
+// strings are converted, the values who can't be converted are ignored (the field is left to its zero value).
+// Each conversion is attached as a warning to the feed (see gbfsspec.Metadata.DecodeWarnings).
+func HTTPOptionLenientDecoding() HTTPOption {
+	return func(c *HTTPClient) {
+		c.lenient = true
+	}
+}
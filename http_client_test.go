@@ -1,10 +1,15 @@
 package gbfs
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -207,3 +212,464 @@ func TestHTTPClient_url_noLanguage(t *testing.T) {
 		}
 	}
 }
+
+func TestHTTPClient_GetContextCanceled(t *testing.T) {
+	c, err := NewHTTPClient(
+		HTTPOptionBaseURL(server.URL),
+		HTTPOptionLanguage("en"),
+	)
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var si gbfsspec.FeedSystemInformation
+	if err := c.GetContext(ctx, gbfsspec.FeedKeySystemInformation, &si); !errors.Is(err, context.Canceled) {
+		t.Errorf("expect '%s' got '%s'", context.Canceled, err)
+	}
+
+	if err := c.RefreshContext(ctx, &si, true); !errors.Is(err, context.Canceled) {
+		t.Errorf("expect '%s' got '%s'", context.Canceled, err)
+	}
+}
+
+func TestHTTPClient_AutoDiscovery(t *testing.T) {
+	var discoveries int
+
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	mux.HandleFunc("/gbfs.json", func(w http.ResponseWriter, r *http.Request) {
+		discoveries++
+		_, _ = fmt.Fprintf(w, `{"last_updated": 1589230640, "ttl": 0, "data": {
+			"en": {"feeds": [{"name": "system_information", "url": "%[1]s/en/system_info"}]},
+			"fr": {"feeds": [{"name": "system_information", "url": "%[1]s/fr/system_info"}]}
+		}}`, s.URL)
+	})
+	mux.HandleFunc("/en/system_info", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, "test/gbfs/en/system_information.json")
+	})
+
+	c, err := NewHTTPClient(
+		HTTPOptionAutoDiscovery(s.URL+"/gbfs.json"),
+		HTTPOptionLanguage("en"),
+	)
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	var si gbfsspec.FeedSystemInformation
+	if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if si.Data.SystemID != "BA" {
+		t.Errorf("expect 'BA' got '%s'", si.Data.SystemID)
+	}
+
+	var ss gbfsspec.FeedStationStatus
+	if err := c.Get(gbfsspec.FeedKeyStationStatus, &ss); !errors.Is(err, ErrFeedNotExist) {
+		t.Errorf("expect '%s' got '%s'", ErrFeedNotExist, err)
+	}
+
+	// ttl is 0, but the auto-discovery feed is fetched at most once by discoveryMinInterval
+	if discoveries != 1 {
+		t.Errorf("expect '1' got '%d'", discoveries)
+	}
+
+	c.(*HTTPClient).discoveryInterval = 0
+
+	if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+	}
+
+	if discoveries != 2 {
+		t.Errorf("expect '2' got '%d'", discoveries)
+	}
+
+	var g gbfsspec.FeedGBFS
+	if err := c.Get(gbfsspec.FeedKeyAutoDiscovery, &g); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+	}
+
+	if l := len(g.Data.Languages); l != 2 {
+		t.Errorf("expect '2' got '%d'", l)
+	}
+}
+
+func TestHTTPClient_AutoDiscoveryFailure(t *testing.T) {
+	var discoveries int
+
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	mux.HandleFunc("/gbfs.json", func(w http.ResponseWriter, r *http.Request) {
+		// only the first fetch succeed
+		if discoveries++; discoveries > 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, `{"last_updated": 1589230640, "ttl": 0, "data": {
+			"en": {"feeds": [{"name": "system_information", "url": "%[1]s/en/system_info"}]}
+		}}`, s.URL)
+	})
+	mux.HandleFunc("/en/system_info", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, "test/gbfs/en/system_information.json")
+	})
+
+	c, err := NewHTTPClient(HTTPOptionAutoDiscovery(s.URL + "/gbfs.json"))
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	var si gbfsspec.FeedSystemInformation
+	if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	// the auto-discovery feed is fetched again and fail, the URLs discovered are still served
+	c.(*HTTPClient).discoveryInterval = 0
+
+	if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+	}
+
+	// the failed fetch count for the minimum interval
+	c.(*HTTPClient).discoveryInterval = time.Hour
+
+	for n := 0; n < 3; n++ {
+		if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+			t.Errorf("expect 'nil' got '%s'", err)
+		}
+	}
+
+	if discoveries != 2 {
+		t.Errorf("expect '2' got '%d'", discoveries)
+	}
+
+	// nothing discovered yet, the error is returned
+	c, err = NewHTTPClient(HTTPOptionAutoDiscovery(s.URL + "/gbfs.json"))
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	var herr *HTTPError
+	if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); !errors.As(err, &herr) {
+		t.Errorf("expect HTTPError got '%v'", err)
+	}
+}
+
+func TestHTTPClient_AutoDiscoveryConcurrent(t *testing.T) {
+	var discoveries int32
+
+	started := make(chan struct{})
+	gate := make(chan struct{})
+
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	mux.HandleFunc("/gbfs.json", func(w http.ResponseWriter, r *http.Request) {
+		// the first fetch is blocked until its caller give up
+		if atomic.AddInt32(&discoveries, 1) == 1 {
+			close(started)
+			<-gate
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, `{"last_updated": 1589230640, "ttl": 0, "data": {
+			"en": {"feeds": [{"name": "system_information", "url": "%[1]s/en/system_info"}]}
+		}}`, s.URL)
+	})
+	mux.HandleFunc("/en/system_info", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, "test/gbfs/en/system_information.json")
+	})
+	defer close(gate)
+
+	c, err := NewHTTPClient(HTTPOptionAutoDiscovery(s.URL + "/gbfs.json"))
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		var si gbfsspec.FeedSystemInformation
+		if err := c.GetContext(ctx, gbfsspec.FeedKeySystemInformation, &si); !errors.Is(err, context.Canceled) {
+			t.Errorf("expect '%s' got '%v'", context.Canceled, err)
+		}
+	}()
+
+	<-started
+
+	// the others callers wait for the fetch in progress, then fetch again once it's canceled
+	for n := 0; n < 8; n++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			var si gbfsspec.FeedSystemInformation
+			if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+				t.Errorf("expect 'nil' got '%s'", err)
+			}
+		}()
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	wg.Wait()
+
+	if d := atomic.LoadInt32(&discoveries); d != 2 {
+		t.Errorf("expect '2' got '%d'", d)
+	}
+}
+
+func TestHTTPClient_AutoDiscoveryLanguageNotExist(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"last_updated": 1589230640, "ttl": 0, "data": {"en": {"feeds": []}}}`)
+	}))
+	defer s.Close()
+
+	c, err := NewHTTPClient(
+		HTTPOptionAutoDiscovery(s.URL),
+		HTTPOptionLanguage("de"),
+	)
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	var si gbfsspec.FeedSystemInformation
+	if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); !errors.Is(err, ErrLanguageNotExist) {
+		t.Errorf("expect '%s' got '%s'", ErrLanguageNotExist, err)
+	}
+}
+
+func TestPickLanguage(t *testing.T) {
+	d := gbfsspec.GBFSData{Languages: map[string]gbfsspec.GBFSLanguage{
+		"fr": {Feeds: []gbfsspec.GBFSFeed{{Name: "fr"}}},
+		"en": {Feeds: []gbfsspec.GBFSFeed{{Name: "en"}}},
+	}}
+
+	ii := []struct {
+		in, out string
+		err     error
+	}{
+		{in: "fr", out: "fr"},
+		{in: "", out: "en"},
+		{in: "de", err: ErrLanguageNotExist},
+	}
+
+	for _, i := range ii {
+		l, err := pickLanguage(d, i.in)
+		if !errors.Is(err, i.err) {
+			t.Errorf("expect '%v' got '%v'", i.err, err)
+			continue
+		}
+
+		if err == nil && l.Feeds[0].Name != i.out {
+			t.Errorf("expect '%s' got '%s'", i.out, l.Feeds[0].Name)
+		}
+	}
+}
+
+func TestHTTPClient_GetHTTPError(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(strings.Repeat("a", 1000)))
+	}))
+	defer s.Close()
+
+	c, err := NewHTTPClient(HTTPOptionBaseURL(s.URL))
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	var si gbfsspec.FeedSystemInformation
+	err = c.Get(gbfsspec.FeedKeySystemInformation, &si)
+
+	var herr *HTTPError
+	if !errors.As(err, &herr) {
+		t.Errorf("expect '*HTTPError' got '%T'", err)
+		t.FailNow()
+	}
+
+	if herr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expect '%d' got '%d'", http.StatusTooManyRequests, herr.StatusCode)
+	}
+
+	if herr.FeedKey != gbfsspec.FeedKeySystemInformation {
+		t.Errorf("expect '%s' got '%s'", gbfsspec.FeedKeySystemInformation, herr.FeedKey)
+	}
+
+	if u := s.URL + "/system_information.json"; herr.URL != u {
+		t.Errorf("expect '%s' got '%s'", u, herr.URL)
+	}
+
+	if herr.RetryAfter != 30*time.Second {
+		t.Errorf("expect '30s' got '%s'", herr.RetryAfter)
+	}
+
+	if l := len(herr.Body); l != httpErrorBodyLimit {
+		t.Errorf("expect '%d' got '%d'", httpErrorBodyLimit, l)
+	}
+
+	if errors.Is(err, ErrFeedNotExist) {
+		t.Errorf("expect 429 to not match '%s'", ErrFeedNotExist)
+	}
+}
+
+func TestHTTPClient_GetConditionalRequests(t *testing.T) {
+	const etag = `"v1"`
+
+	var calls int
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", etag)
+		http.ServeFile(w, r, "test/gbfs/en/system_information.json")
+	}))
+	defer s.Close()
+
+	c, err := NewHTTPClient(
+		HTTPOptionBaseURL(s.URL),
+		HTTPOptionConditionalRequests(),
+	)
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	var si gbfsspec.FeedSystemInformation
+	if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	si.Data.Name = "untouched"
+
+	if err := c.Refresh(&si, true); !errors.Is(err, ErrNotModified) {
+		t.Errorf("expect '%s' got '%s'", ErrNotModified, err)
+	}
+
+	if si.Data.Name != "untouched" {
+		t.Errorf("expect 'untouched' got '%s'", si.Data.Name)
+	}
+
+	if calls != 2 {
+		t.Errorf("expect '2' got '%d'", calls)
+	}
+}
+
+func TestHTTPClient_GetConditionalRequestsNewFeed(t *testing.T) {
+	const etag = `"v1"`
+
+	var conditionals int
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			conditionals++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", etag)
+		http.ServeFile(w, r, "test/gbfs/en/system_information.json")
+	}))
+	defer s.Close()
+
+	c, err := NewHTTPClient(
+		HTTPOptionBaseURL(s.URL),
+		HTTPOptionConditionalRequests(),
+	)
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	var first gbfsspec.FeedSystemInformation
+	if err := c.Get(gbfsspec.FeedKeySystemInformation, &first); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if err := c.Refresh(&first, true); !errors.Is(err, ErrNotModified) {
+		t.Errorf("expect '%s' got '%s'", ErrNotModified, err)
+	}
+
+	// a new structure never hold the previous response, the whole feed is fetched
+	var second gbfsspec.FeedSystemInformation
+	if err := c.Get(gbfsspec.FeedKeySystemInformation, &second); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if second.Data.Name != first.Data.Name {
+		t.Errorf("expect '%s' got '%s'", first.Data.Name, second.Data.Name)
+	}
+
+	// a feed who wasn't decoded from the last response is refreshed with the whole feed
+	var other gbfsspec.FeedSystemInformation
+	if err := c.Refresh(&other, true); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+	}
+
+	if other.Data.Name != first.Data.Name {
+		t.Errorf("expect '%s' got '%s'", first.Data.Name, other.Data.Name)
+	}
+
+	if conditionals != 1 {
+		t.Errorf("expect '1' got '%d'", conditionals)
+	}
+
+	// the validators are forgotten with the URLs
+	c.ForceURLs(map[string]string{gbfsspec.FeedKeySystemInformation: s.URL + "/other.json"}, false)
+
+	if err := c.Refresh(&first, true); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+	}
+
+	cc := NewCachingClient(c)
+
+	for i := 0; i < 2; i++ {
+		var si gbfsspec.FeedSystemInformation
+		if err := cc.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+			t.Errorf("expect 'nil' got '%s'", err)
+		}
+
+		if si.Data.Name != first.Data.Name {
+			t.Errorf("expect '%s' got '%s'", first.Data.Name, si.Data.Name)
+		}
+	}
+
+	// the expired copy of the cache is revalidated
+	if conditionals != 2 {
+		t.Errorf("expect '2' got '%d'", conditionals)
+	}
+}
@@ -1,11 +1,27 @@
 package gbfs
 
+import "context"
+
 type (
 	// Client interface to interact with GBFS provider
 	Client interface {
 		ForceURLs(map[string]string, bool)
 		Get(string, Feed) error
+		GetContext(context.Context, string, Feed) error
 		Refresh(Feed, bool) error
+		RefreshContext(context.Context, Feed, bool) error
+	}
+
+	// VersionedClient is a Client who know the version of the spec of the feeds
+	// HTTPClient implement it, CachingClient forward it to the wrapped client
+	VersionedClient interface {
+		Client
+
+		// Version return the version of the spec, empty until known
+		Version() string
+
+		// Negotiate fetch the auto-discovery feed when needed, and return the version of the spec
+		Negotiate(context.Context) (string, error)
 	}
 
 	// HTTPOption for HTTPClient
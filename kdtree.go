@@ -0,0 +1,166 @@
+package gbfs
+
+import (
+	"math"
+	"sort"
+)
+
+// Mean radius of the Earth in meters
+const earthRadius = 6371008.8
+
+// kdTree is a balanced k-d tree over positions converted to 3D unit vectors, free of the antimeridian
+// and the poles issues. The tree is implicit: the median of each range of 'order' split its children.
+type kdTree struct {
+	points [][3]float64
+
+	// indexes of the points, ordered as the tree
+	order []int
+}
+
+// Distance return the great-circle distance in meters between two positions, with the haversine formula
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	p1, p2 := lat1*math.Pi/180, lat2*math.Pi/180
+	dp, dl := p2-p1, (lon2-lon1)*math.Pi/180
+
+	a := math.Sin(dp/2)*math.Sin(dp/2) + math.Cos(p1)*math.Cos(p2)*math.Sin(dl/2)*math.Sin(dl/2)
+
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// unitVector convert the position to a point of the unit sphere
+func unitVector(lat, lon float64) [3]float64 {
+	p, l := lat*math.Pi/180, lon*math.Pi/180
+
+	return [3]float64{math.Cos(p) * math.Cos(l), math.Cos(p) * math.Sin(l), math.Sin(p)}
+}
+
+// chord return the straight line distance through the unit sphere for a distance in meters on its surface
+// It's slightly enlarged, so the points at the border are decided by the haversine formula
+func chord(meters float64) float64 {
+	return 2 * math.Sin(math.Min(meters/earthRadius, math.Pi)/2) * (1 + 1e-9)
+}
+
+func newKDTree(points [][3]float64) kdTree {
+	t := kdTree{points: points, order: make([]int, len(points))}
+	for i := range t.order {
+		t.order[i] = i
+	}
+
+	t.build(0, len(t.order), 0)
+
+	return t
+}
+
+func (t kdTree) build(lo, hi, axis int) {
+	if hi-lo <= 1 {
+		return
+	}
+
+	o := t.order[lo:hi]
+	sort.Slice(o, func(i, j int) bool { return t.points[o[i]][axis] < t.points[o[j]][axis] })
+
+	mid := lo + (hi-lo)/2
+	t.build(lo, mid, (axis+1)%3)
+	t.build(mid+1, hi, (axis+1)%3)
+}
+
+func squaredDistance(a, b [3]float64) float64 {
+	dx, dy, dz := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+
+	return dx*dx + dy*dy + dz*dz
+}
+
+// nearest return the indexes of the 'k' nearest points of 'p' accepted by 'accept', the nearest first
+func (t kdTree) nearest(p [3]float64, k int, accept func(i int) bool) []int {
+	if k <= 0 {
+		return nil
+	}
+
+	type hit struct {
+		i int
+		d float64
+	}
+
+	var hits []hit
+
+	var search func(lo, hi, axis int)
+	search = func(lo, hi, axis int) {
+		if lo >= hi {
+			return
+		}
+
+		mid := lo + (hi-lo)/2
+		i := t.order[mid]
+
+		if accept(i) {
+			d := squaredDistance(p, t.points[i])
+
+			if len(hits) < k || d < hits[len(hits)-1].d {
+				n := sort.Search(len(hits), func(j int) bool { return hits[j].d > d })
+				hits = append(hits, hit{})
+				copy(hits[n+1:], hits[n:])
+				hits[n] = hit{i: i, d: d}
+
+				if len(hits) > k {
+					hits = hits[:k]
+				}
+			}
+		}
+
+		delta := p[axis] - t.points[i][axis]
+		near, far := [2]int{lo, mid}, [2]int{mid + 1, hi}
+		if delta > 0 {
+			near, far = far, near
+		}
+
+		search(near[0], near[1], (axis+1)%3)
+
+		if len(hits) < k || delta*delta < hits[len(hits)-1].d {
+			search(far[0], far[1], (axis+1)%3)
+		}
+	}
+
+	search(0, len(t.order), 0)
+
+	ii := make([]int, len(hits))
+	for n, h := range hits {
+		ii[n] = h.i
+	}
+
+	return ii
+}
+
+// within return the indexes of the points at most at the chord distance 'r' of 'p' accepted by 'accept'
+func (t kdTree) within(p [3]float64, r float64, accept func(i int) bool) []int {
+	var ii []int
+
+	r2 := r * r
+
+	var search func(lo, hi, axis int)
+	search = func(lo, hi, axis int) {
+		if lo >= hi {
+			return
+		}
+
+		mid := lo + (hi-lo)/2
+		i := t.order[mid]
+
+		if squaredDistance(p, t.points[i]) <= r2 && accept(i) {
+			ii = append(ii, i)
+		}
+
+		delta := p[axis] - t.points[i][axis]
+
+		if delta <= r {
+			search(lo, mid, (axis+1)%3)
+		}
+
+		if delta >= -r {
+			search(mid+1, hi, (axis+1)%3)
+		}
+	}
+
+	search(0, len(t.order), 0)
+
+	return ii
+}
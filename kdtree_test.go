@@ -0,0 +1,77 @@
+package gbfs
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	ii := []struct {
+		lat1, lon1, lat2, lon2 float64
+		out                    float64
+	}{
+		{lat1: 48.8566, lon1: 2.3522, lat2: 48.8566, lon2: 2.3522, out: 0},
+		// Paris - London
+		{lat1: 48.8566, lon1: 2.3522, lat2: 51.5074, lon2: -0.1278, out: 343556},
+		// across the antimeridian
+		{lat1: 0, lon1: 179.999, lat2: 0, lon2: -179.999, out: 222},
+	}
+
+	for _, i := range ii {
+		if d := Distance(i.lat1, i.lon1, i.lat2, i.lon2); math.Abs(d-i.out) > 1 {
+			t.Errorf("expect '%f' got '%f'", i.out, d)
+		}
+	}
+}
+
+func TestKDTree(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	positions := make([][2]float64, 500)
+	points := make([][3]float64, len(positions))
+	for i := range positions {
+		positions[i] = [2]float64{r.Float64()*180 - 90, r.Float64()*360 - 180}
+		points[i] = unitVector(positions[i][0], positions[i][1])
+	}
+
+	tree := newKDTree(points)
+	even := func(i int) bool { return i%2 == 0 }
+
+	for q := 0; q < 20; q++ {
+		lat, lon := r.Float64()*180-90, r.Float64()*360-180
+
+		// brute force
+		var bf []int
+		for i := range positions {
+			if even(i) {
+				bf = append(bf, i)
+			}
+		}
+
+		sort.Slice(bf, func(a, b int) bool {
+			return Distance(lat, lon, positions[bf[a]][0], positions[bf[a]][1]) < Distance(lat, lon, positions[bf[b]][0], positions[bf[b]][1])
+		})
+
+		nn := tree.nearest(unitVector(lat, lon), 5, even)
+		for n, i := range nn {
+			if i != bf[n] {
+				t.Errorf("expect '%v' got '%v'", bf[:5], nn)
+				break
+			}
+		}
+
+		radius := 2000e3
+		var in int
+		for _, i := range bf {
+			if Distance(lat, lon, positions[i][0], positions[i][1]) <= radius {
+				in++
+			}
+		}
+
+		if w := tree.within(unitVector(lat, lon), chord(radius), even); len(w) != in {
+			t.Errorf("expect '%d' got '%d'", in, len(w))
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package gbfs
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+// coerce convert the JSON value 'v' to the type 't', and return false when it can't be converted
+// The types with a custom decoding are not converted
+func coerce(path string, v interface{}, t reflect.Type, ww *[]gbfsspec.DecodeWarning) (interface{}, bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if v == nil || reflect.PtrTo(t).Implements(unmarshalerType) {
+		return v, true
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if o, ok := v.(map[string]interface{}); ok {
+			fields := jsonFields(t)
+
+			for _, k := range sortedKeys(o) {
+				if f, ok := lookupField(fields, k); ok {
+					coerceEntry(o, k, joinPath(path, k), f.typ, ww)
+				}
+			}
+		}
+	case reflect.Map:
+		if o, ok := v.(map[string]interface{}); ok {
+			for _, k := range sortedKeys(o) {
+				coerceEntry(o, k, joinPath(path, k), t.Elem(), ww)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if a, ok := v.([]interface{}); ok {
+			for i, e := range a {
+				ev, ok := coerce(path+"["+strconv.Itoa(i)+"]", e, t.Elem(), ww)
+				if !ok {
+					ev = nil
+				}
+
+				a[i] = ev
+			}
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+		return coerceNumber(path, v, t, ww)
+	case reflect.String:
+		if n, ok := v.(json.Number); ok {
+			*ww = append(*ww, coercedWarning(path, v, "string"))
+			return string(n), true
+		}
+	}
+
+	return v, true
+}
+
+// coerceEntry convert the value of the key 'k' of the JSON object, and remove it when it can't be converted
+func coerceEntry(o map[string]interface{}, k, path string, t reflect.Type, ww *[]gbfsspec.DecodeWarning) {
+	v, ok := coerce(path, o[k], t, ww)
+	if !ok {
+		delete(o, k)
+		return
+	}
+
+	o[k] = v
+}
+
+// coerceNumber convert the JSON value to a number of the type 't', truncated for the integers
+// The values out of the range of the type are ignored
+func coerceNumber(path string, v interface{}, t reflect.Type, ww *[]gbfsspec.DecodeWarning) (interface{}, bool) {
+	var s string
+
+	switch v := v.(type) {
+	case json.Number:
+		s = string(v)
+	case string:
+		s = strings.TrimSpace(v)
+	default:
+		*ww = append(*ww, invalidWarning(path, v))
+		return nil, false
+	}
+
+	integer := t.Kind() != reflect.Float32 && t.Kind() != reflect.Float64
+
+	if _, err := strconv.ParseInt(s, 10, t.Bits()); err == nil {
+		return numberOf(path, v, s, ww)
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		*ww = append(*ww, invalidWarning(path, v))
+		return nil, false
+	}
+
+	if !integer {
+		if _, err := strconv.ParseFloat(s, t.Bits()); err != nil {
+			*ww = append(*ww, rangeWarning(path, v, t))
+			return nil, false
+		}
+
+		return numberOf(path, v, s, ww)
+	}
+
+	// the range of a signed integer of n bits is [-2^(n-1), 2^(n-1))
+	f = math.Trunc(f)
+	if limit := math.Ldexp(1, t.Bits()-1); f < -limit || f >= limit {
+		*ww = append(*ww, rangeWarning(path, v, t))
+		return nil, false
+	}
+
+	return numberOf(path, v, strconv.FormatInt(int64(f), 10), ww)
+}
+
+// numberOf return the JSON number 's', and report the conversion when 'v' was not already this number
+func numberOf(path string, v interface{}, s string, ww *[]gbfsspec.DecodeWarning) (interface{}, bool) {
+	if n, ok := v.(json.Number); !ok || string(n) != s {
+		*ww = append(*ww, coercedWarning(path, v, "number"))
+	}
+
+	return json.Number(s), true
+}
+
+func coercedWarning(path string, v interface{}, to string) gbfsspec.DecodeWarning {
+	return gbfsspec.DecodeWarning{
+		Path:    path,
+		Kind:    gbfsspec.DecodeWarningCoercedValue,
+		Message: fmt.Sprintf("value %s converted to %s", jsonString(v), to),
+	}
+}
+
+func invalidWarning(path string, v interface{}) gbfsspec.DecodeWarning {
+	return gbfsspec.DecodeWarning{
+		Path:    path,
+		Kind:    gbfsspec.DecodeWarningInvalidValue,
+		Message: fmt.Sprintf("value %s ignored, invalid type", jsonString(v)),
+	}
+}
+
+func rangeWarning(path string, v interface{}, t reflect.Type) gbfsspec.DecodeWarning {
+	return gbfsspec.DecodeWarning{
+		Path:    path,
+		Kind:    gbfsspec.DecodeWarningInvalidValue,
+		Message: fmt.Sprintf("value %s ignored, out of the range of %s", jsonString(v), t),
+	}
+}
+
+func jsonString(v interface{}) string {
+	bs, _ := json.Marshal(v)
+
+	return string(bs)
+}
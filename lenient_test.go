@@ -0,0 +1,143 @@
+package gbfs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+const lenientStationInformation = `{"last_updated": 1600000000.0, "ttl": "60", "data": {"stations": [
+	{"station_id": 12, "name": "Market St", "lat": "45.5", "lon": -73.6, "capacity": "12"},
+	{"station_id": "13", "name": "Mission St", "lat": 45.51, "lon": -73.61, "capacity": "twelve"}
+]}}`
+
+func TestDecodeFeed_lenient(t *testing.T) {
+	var si gbfsspec.FeedStationInformation
+	if err := decodeFeed([]byte(lenientStationInformation), &si, false, true); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if si.LastUpdated != 1600000000 || si.TTL != 60 {
+		t.Errorf("expect '1600000000, 60' got '%d, %d'", si.LastUpdated, si.TTL)
+	}
+
+	s := si.Data.Stations[0]
+	if s.StationID != "12" || s.Latitude != 45.5 || s.Longitude != -73.6 || s.Capacity != 12 {
+		t.Errorf("expect '12, 45.5, -73.6, 12' got '%s, %f, %f, %d'", s.StationID, s.Latitude, s.Longitude, s.Capacity)
+	}
+
+	if c := si.Data.Stations[1].Capacity; c != 0 {
+		t.Errorf("expect '0' got '%d'", c)
+	}
+
+	ii := []gbfsspec.DecodeWarning{
+		{Path: "data.stations[0].capacity", Kind: gbfsspec.DecodeWarningCoercedValue},
+		{Path: "data.stations[0].lat", Kind: gbfsspec.DecodeWarningCoercedValue},
+		{Path: "data.stations[0].station_id", Kind: gbfsspec.DecodeWarningCoercedValue},
+		{Path: "data.stations[1].capacity", Kind: gbfsspec.DecodeWarningInvalidValue},
+		{Path: "last_updated", Kind: gbfsspec.DecodeWarningCoercedValue},
+		{Path: "ttl", Kind: gbfsspec.DecodeWarningCoercedValue},
+	}
+
+	ww := si.DecodeWarnings()
+	if len(ww) != len(ii) {
+		t.Errorf("expect '%d' got '%d' (%v)", len(ii), len(ww), ww)
+		t.FailNow()
+	}
+
+	for n, i := range ii {
+		if ww[n].Path != i.Path || ww[n].Kind != i.Kind {
+			t.Errorf("expect '%s (%s)' got '%s (%s)'", i.Path, i.Kind, ww[n].Path, ww[n].Kind)
+		}
+	}
+}
+
+func TestCoerceNumber(t *testing.T) {
+	var (
+		i64 = reflect.TypeOf(int64(0))
+		i8  = reflect.TypeOf(int8(0))
+		f64 = reflect.TypeOf(float64(0))
+		f32 = reflect.TypeOf(float32(0))
+	)
+
+	ii := []struct {
+		in      interface{}
+		typ     reflect.Type
+		out     string
+		ok      bool
+		warning bool
+	}{
+		{in: "12", typ: i64, out: "12", ok: true, warning: true},
+		{in: " 12 ", typ: i64, out: "12", ok: true, warning: true},
+		{in: "1600000000.9", typ: i64, out: "1600000000", ok: true, warning: true},
+		{in: "45.5", typ: f64, out: "45.5", ok: true, warning: true},
+		{in: json.Number("127"), typ: i8, out: "127", ok: true, warning: false},
+		{in: true, typ: f64, ok: false, warning: true},
+		{in: "", typ: i64, ok: false, warning: true},
+		{in: "NaN", typ: f64, ok: false, warning: true},
+		// out of range
+		{in: "1e30", typ: i64, ok: false, warning: true},
+		{in: json.Number("-1e19"), typ: i64, ok: false, warning: true},
+		{in: "9223372036854775808", typ: i64, ok: false, warning: true},
+		{in: json.Number("128"), typ: i8, ok: false, warning: true},
+		{in: "-128.5", typ: i8, out: "-128", ok: true, warning: true},
+		{in: "1e39", typ: f32, ok: false, warning: true},
+		{in: "1e39", typ: f64, out: "1e39", ok: true, warning: true},
+	}
+
+	for _, i := range ii {
+		var ww []gbfsspec.DecodeWarning
+
+		v, ok := coerceNumber("x", i.in, i.typ, &ww)
+		if ok != i.ok {
+			t.Errorf("expect '%t' got '%t' for '%v'", i.ok, ok, i.in)
+		}
+
+		if ok && fmt.Sprint(v) != i.out {
+			t.Errorf("expect '%s' got '%v'", i.out, v)
+		}
+
+		if (len(ww) > 0) != i.warning {
+			t.Errorf("expect warning '%t' got '%v'", i.warning, ww)
+		}
+
+		if !ok && len(ww) > 0 && ww[0].Kind != gbfsspec.DecodeWarningInvalidValue {
+			t.Errorf("expect '%s' got '%s'", gbfsspec.DecodeWarningInvalidValue, ww[0].Kind)
+		}
+	}
+}
+
+func TestHTTPClient_LenientDecoding(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, lenientStationInformation)
+	}))
+	defer s.Close()
+
+	ii := []struct {
+		opts []HTTPOption
+		err  bool
+	}{
+		{opts: []HTTPOption{HTTPOptionBaseURL(s.URL)}, err: true},
+		{opts: []HTTPOption{HTTPOptionBaseURL(s.URL), HTTPOptionLenientDecoding()}, err: false},
+		{opts: []HTTPOption{HTTPOptionBaseURL(s.URL), HTTPOptionLenientDecoding(), HTTPOptionStrictDecoding()}, err: false},
+	}
+
+	for _, i := range ii {
+		c, err := NewHTTPClient(i.opts...)
+		if err != nil {
+			t.Errorf("expect 'nil' got '%s'", err)
+			t.FailNow()
+		}
+
+		var si gbfsspec.FeedStationInformation
+		if err := c.Get(gbfsspec.FeedKeyStationInformation, &si); (err != nil) != i.err {
+			t.Errorf("expect error '%t' got '%v'", i.err, err)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package model
+
+type (
+	// Converter convert the feeds of one version of the spec into the canonical model
+	//
+	// To support a new version, add a type holding the feeds of the spec package (nil when the feed is not
+	// published) and implement Converter on it. Convert must return an error only when a required feed is missing,
+	// the fields and feeds absent from the version are left to the defaults documented on the model.
+	Converter interface {
+		// Version of the spec read by the converter
+		Version() string
+
+		// Convert the feeds into a snapshot
+		Convert() (*Snapshot, error)
+	}
+)
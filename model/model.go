@@ -0,0 +1,222 @@
+// Package model is a representation of a bike share system independent of the version of the GBFS specification.
+// The feeds of each version are converted to it by a Converter.
+//
+// Enum values follow the latest version of the specification (e.g. lowercase rental methods and alert types).
+// Fields absent from the version converted are left to their zero value, unless documented otherwise.
+package model
+
+import "time"
+
+// Enum from the spec
+type (
+	FormFactor     string
+	PropulsionType string
+	AlertType      string
+	RentalMethod   string
+)
+
+const (
+	FormFactorBicycle FormFactor = "bicycle"
+)
+
+const (
+	PropulsionTypeHuman PropulsionType = "human"
+)
+
+type (
+	// Snapshot of a system
+	Snapshot struct {
+		// Version of the spec of the feeds
+		Version string
+
+		System       System
+		Stations     []Station
+		Vehicles     []Vehicle
+		VehicleTypes []VehicleType
+		Plans        []PricingPlan
+		Alerts       []Alert
+		Regions      []Region
+		Zones        []Zone
+	}
+
+	System struct {
+		ID               string
+		Languages        []string
+		Name             string
+		ShortName        string
+		Operator         string
+		URL              string
+		PurchaseURL      string
+		PhoneNumber      string
+		Email            string
+		FeedContactEmail string
+		Timezone         string
+		LicenseURL       string
+
+		// Zero when unknown
+		StartDate time.Time
+	}
+
+	Station struct {
+		ID            string
+		Name          string
+		ShortName     string
+		Latitude      float64
+		Longitude     float64
+		Address       string
+		CrossStreet   string
+		RegionID      string
+		PostCode      string
+		RentalMethods []RentalMethod
+		RentalURIs    RentalURIs
+
+		// Number of docking points, 0 when unknown
+		Capacity int
+
+		// False for the versions without virtual stations
+		IsVirtual bool
+
+		// Live status, nil when the station is not in station_status
+		Status *StationStatus
+	}
+
+	StationStatus struct {
+		NumVehiclesAvailable int
+		NumVehiclesDisabled  int
+		NumDocksAvailable    int
+		NumDocksDisabled     int
+
+		// Number of vehicles available by vehicle type, nil for the versions without vehicle types
+		VehicleTypesAvailable map[string]int
+
+		IsInstalled  bool
+		IsRenting    bool
+		IsReturning  bool
+		LastReported time.Time
+	}
+
+	Vehicle struct {
+		ID         string
+		Latitude   float64
+		Longitude  float64
+		IsReserved bool
+		IsDisabled bool
+		RentalURIs RentalURIs
+
+		// Identifier of the vehicle type, DefaultVehicleType.ID for the versions without vehicle types
+		VehicleTypeID string
+
+		// Station where the vehicle is parked, empty when the vehicle is free floating
+		StationID string
+
+		// Pricing plan of the vehicle, empty when unknown
+		PricingPlanID string
+
+		// Zero when unknown
+		LastReported time.Time
+
+		// Distance in meters the vehicle can travel with its current charge or fuel, nil when unknown
+		CurrentRangeMeters *float64
+	}
+
+	VehicleType struct {
+		ID             string
+		FormFactor     FormFactor
+		PropulsionType PropulsionType
+		Name           string
+
+		// 0 when unknown, or when the vehicle doesn't have a motor
+		MaxRangeMeters float64
+	}
+
+	PricingPlan struct {
+		ID          string
+		URL         string
+		Name        string
+		Currency    string
+		Price       float64
+		IsTaxable   bool
+		Description string
+
+		// Nil for the versions without segments
+		PerKmPricing  []PricingSegment
+		PerMinPricing []PricingSegment
+
+		SurgePricing bool
+	}
+
+	PricingSegment struct {
+		Start    int
+		Rate     float64
+		Interval int
+
+		// 0 when the rate applies until the end of the trip
+		End int
+	}
+
+	Alert struct {
+		ID          string
+		Type        AlertType
+		Times       []AlertTime
+		StationIDs  []string
+		RegionIDs   []string
+		URL         string
+		Summary     string
+		Description string
+
+		// Zero when unknown
+		LastUpdated time.Time
+	}
+
+	AlertTime struct {
+		Start time.Time
+
+		// Zero when the end is not planned
+		End time.Time
+	}
+
+	Region struct {
+		ID   string
+		Name string
+	}
+
+	Zone struct {
+		Name string
+
+		// GeoJSON MultiPolygon coordinates, [longitude, latitude] positions
+		Coordinates [][][][]float64
+
+		// Zero when the zone is always active
+		Start time.Time
+		End   time.Time
+
+		Rules []ZoneRule
+	}
+
+	ZoneRule struct {
+		// Empty when the rule applies to all vehicle types
+		VehicleTypeIDs     []string
+		RideStartAllowed   bool
+		RideEndAllowed     bool
+		RideThroughAllowed bool
+
+		// 0 when there is no speed limit
+		MaximumSpeedKph int
+
+		StationParking bool
+	}
+
+	RentalURIs struct {
+		Android string
+		IOS     string
+		Web     string
+	}
+)
+
+// DefaultVehicleType is the vehicle type of the vehicles for the versions without vehicle types,
+// who only describe human powered bicycles
+var DefaultVehicleType = VehicleType{
+	ID:             "",
+	FormFactor:     FormFactorBicycle,
+	PropulsionType: PropulsionTypeHuman,
+}
@@ -0,0 +1,212 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Eraac/gbfs"
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+type (
+	// V20 convert the feeds of the version 2.0 of the spec, a nil feed is not published
+	V20 struct {
+		SystemInformation  *gbfsspec.FeedSystemInformation
+		StationInformation *gbfsspec.FeedStationInformation
+		StationStatus      *gbfsspec.FeedStationStatus
+		FreeBikeStatus     *gbfsspec.FeedFreeBikeStatus
+		SystemRegions      *gbfsspec.FeedSystemRegions
+		SystemPricingPlans *gbfsspec.FeedSystemPricingPlans
+		SystemAlerts       *gbfsspec.FeedSystemAlerts
+	}
+)
+
+// NewV20 return a converter for the feeds of the system
+func NewV20(s *gbfs.System) V20 {
+	return V20{
+		SystemInformation:  s.SystemInformation,
+		StationInformation: s.StationInformation,
+		StationStatus:      s.StationStatus,
+		FreeBikeStatus:     s.FreeBikeStatus,
+		SystemRegions:      s.SystemRegions,
+		SystemPricingPlans: s.SystemPricingPlans,
+		SystemAlerts:       s.SystemAlerts,
+	}
+}
+
+// Version return the version of the spec read by the converter
+func (c V20) Version() string {
+	return gbfsspec.Version
+}
+
+// Convert the feeds into a snapshot
+// The bikes are converted to vehicles of the DefaultVehicleType, and the prices who are not a number to 0.
+func (c V20) Convert() (*Snapshot, error) {
+	if c.SystemInformation == nil {
+		return nil, fmt.Errorf("%s: %w", gbfsspec.FeedKeySystemInformation, gbfs.ErrFeedNotExist)
+	}
+
+	s := &Snapshot{
+		Version: c.Version(),
+		System:  c.system(c.SystemInformation.Data),
+	}
+
+	if c.StationInformation != nil {
+		s.Stations = c.stations(c.StationInformation.Data.Stations)
+	}
+
+	if c.FreeBikeStatus != nil {
+		s.Vehicles = make([]Vehicle, 0, len(c.FreeBikeStatus.Data.Bikes))
+		s.VehicleTypes = []VehicleType{DefaultVehicleType}
+
+		for _, b := range c.FreeBikeStatus.Data.Bikes {
+			s.Vehicles = append(s.Vehicles, Vehicle{
+				ID:            b.BikeID,
+				Latitude:      b.Latitude,
+				Longitude:     b.Longitude,
+				IsReserved:    bool(b.IsReserved),
+				IsDisabled:    bool(b.IsDisabled),
+				RentalURIs:    rentalURIs(b.RentalURIs),
+				VehicleTypeID: DefaultVehicleType.ID,
+			})
+		}
+	}
+
+	if c.SystemRegions != nil {
+		for _, r := range c.SystemRegions.Data.Regions {
+			s.Regions = append(s.Regions, Region{ID: r.RegionID, Name: r.Name})
+		}
+	}
+
+	if c.SystemPricingPlans != nil {
+		for _, p := range c.SystemPricingPlans.Data.Plans {
+			price, _ := strconv.ParseFloat(string(p.Price), 64)
+
+			s.Plans = append(s.Plans, PricingPlan{
+				ID:          p.PlanID,
+				URL:         p.URL,
+				Name:        p.Name,
+				Currency:    p.Currency,
+				Price:       price,
+				IsTaxable:   bool(p.IsTaxable),
+				Description: p.Description,
+			})
+		}
+	}
+
+	if c.SystemAlerts != nil {
+		for _, a := range c.SystemAlerts.Data.Alerts {
+			s.Alerts = append(s.Alerts, alert(a))
+		}
+	}
+
+	return s, nil
+}
+
+func (c V20) system(d gbfsspec.SystemInformationData) System {
+	s := System{
+		ID:               d.SystemID,
+		Name:             d.Name,
+		ShortName:        d.ShortName,
+		Operator:         d.Operator,
+		URL:              d.URL,
+		PurchaseURL:      d.PurchaseURL,
+		PhoneNumber:      d.PhoneNumber,
+		Email:            d.Email,
+		FeedContactEmail: d.FeedContactEmail,
+		Timezone:         d.Timezone,
+		LicenseURL:       d.LicenseURL,
+	}
+
+	if d.Language != "" {
+		s.Languages = []string{d.Language}
+	}
+
+	if d.StartDate != "" {
+		s.StartDate, _ = d.GetStartDate()
+	}
+
+	return s
+}
+
+func (c V20) stations(ii []gbfsspec.StationInformation) []Station {
+	status := make(map[string]gbfsspec.StationStatus)
+	if c.StationStatus != nil {
+		for _, s := range c.StationStatus.Data.Stations {
+			status[s.StationID] = s
+		}
+	}
+
+	ss := make([]Station, 0, len(ii))
+
+	for _, i := range ii {
+		s := Station{
+			ID:          i.StationID,
+			Name:        i.Name,
+			ShortName:   i.ShortName,
+			Latitude:    i.Latitude,
+			Longitude:   i.Longitude,
+			Address:     i.Address,
+			CrossStreet: i.CrossStreet,
+			RegionID:    i.RegionID,
+			PostCode:    i.PostCode,
+			RentalURIs:  rentalURIs(i.RentalURIs),
+			Capacity:    i.Capacity,
+		}
+
+		for _, m := range i.RentalMethods {
+			s.RentalMethods = append(s.RentalMethods, RentalMethod(strings.ToLower(string(m))))
+		}
+
+		if st, ok := status[i.StationID]; ok {
+			s.Status = &StationStatus{
+				NumVehiclesAvailable: st.NumBikesAvailable,
+				NumVehiclesDisabled:  st.NumBikesDisabled,
+				NumDocksAvailable:    st.NumDocksAvailable,
+				NumDocksDisabled:     st.NumDocksDisabled,
+				IsInstalled:          bool(st.IsInstalled),
+				IsRenting:            bool(st.IsRenting),
+				IsReturning:          bool(st.IsReturning),
+				LastReported:         st.LastReported.ToTime(),
+			}
+		}
+
+		ss = append(ss, s)
+	}
+
+	return ss
+}
+
+func alert(a gbfsspec.SystemAlert) Alert {
+	m := Alert{
+		ID:          a.AlertID,
+		Type:        AlertType(strings.ToLower(string(a.Type))),
+		StationIDs:  a.StationIDs,
+		RegionIDs:   a.RegionIDs,
+		URL:         a.URL,
+		Summary:     a.Summary,
+		Description: a.Description,
+		LastUpdated: toTime(a.LastUpdated),
+	}
+
+	for _, t := range a.Times {
+		m.Times = append(m.Times, AlertTime{Start: toTime(t.Start), End: toTime(t.End)})
+	}
+
+	return m
+}
+
+func rentalURIs(u gbfsspec.RentalURIs) RentalURIs {
+	return RentalURIs{Android: u.Android, IOS: u.IOS, Web: u.Web}
+}
+
+// toTime return the zero time.Time for a timestamp omitted
+func toTime(t gbfsspec.Timestamp) time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+
+	return t.ToTime()
+}
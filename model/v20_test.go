@@ -0,0 +1,106 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Eraac/gbfs"
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+func TestV20_Convert(t *testing.T) {
+	c := NewV20(&gbfs.System{
+		SystemInformation: &gbfsspec.FeedSystemInformation{Data: gbfsspec.SystemInformationData{
+			SystemID: "BA",
+			Language: "en",
+			Name:     "Bay Wheels",
+			Timezone: "UTC",
+		}},
+		StationInformation: &gbfsspec.FeedStationInformation{Data: gbfsspec.StationInformationData{
+			Stations: []gbfsspec.StationInformation{
+				{StationID: "1", Name: "Market St", RentalMethods: []gbfsspec.RentalMethod{gbfsspec.RentalMethodCreditCard}},
+				{StationID: "2", Name: "Mission St"},
+			},
+		}},
+		StationStatus: &gbfsspec.FeedStationStatus{Data: gbfsspec.StationStatusData{
+			Stations: []gbfsspec.StationStatus{{StationID: "1", NumBikesAvailable: 4, IsRenting: true, LastReported: 1589230640}},
+		}},
+		FreeBikeStatus: &gbfsspec.FeedFreeBikeStatus{Data: gbfsspec.FreeBikeStatusData{
+			Bikes: []gbfsspec.FreeBikeStatus{{BikeID: "b1", Latitude: 37.7, Longitude: -122.4, IsReserved: true}},
+		}},
+		SystemPricingPlans: &gbfsspec.FeedSystemPricingPlans{Data: gbfsspec.SystemPricingPlansData{
+			Plans: []gbfsspec.SystemPricingPlan{{PlanID: "p1", Price: "2.50"}, {PlanID: "p2", Price: "free"}},
+		}},
+		SystemAlerts: &gbfsspec.FeedSystemAlerts{Data: gbfsspec.SystemAlertsData{
+			Alerts: []gbfsspec.SystemAlert{{AlertID: "a1", Type: gbfsspec.AlertTypeStationClosure,
+				Times: []gbfsspec.SystemAlertTime{{Start: 1589230640}}}},
+		}},
+	})
+
+	s, err := c.Convert()
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if s.Version != "2.0" || s.System.ID != "BA" || s.System.Languages[0] != "en" {
+		t.Errorf("expect '2.0, BA, en' got '%s, %s, %v'", s.Version, s.System.ID, s.System.Languages)
+	}
+
+	if l := len(s.Stations); l != 2 {
+		t.Errorf("expect '2' got '%d'", l)
+		t.FailNow()
+	}
+
+	st := s.Stations[0]
+	if st.Status == nil || st.Status.NumVehiclesAvailable != 4 || !st.Status.IsRenting {
+		t.Errorf("expect status with 4 vehicles available got '%v'", st.Status)
+	}
+
+	if st.Status != nil && st.Status.LastReported.Unix() != 1589230640 {
+		t.Errorf("expect '1589230640' got '%d'", st.Status.LastReported.Unix())
+	}
+
+	if st.RentalMethods[0] != "creditcard" {
+		t.Errorf("expect 'creditcard' got '%s'", st.RentalMethods[0])
+	}
+
+	if s.Stations[1].Status != nil {
+		t.Errorf("expect 'nil' got '%v'", s.Stations[1].Status)
+	}
+
+	v := s.Vehicles[0]
+	if v.ID != "b1" || !v.IsReserved || v.VehicleTypeID != DefaultVehicleType.ID || v.CurrentRangeMeters != nil {
+		t.Errorf("expect vehicle 'b1' with defaults got '%v'", v)
+	}
+
+	if !v.LastReported.IsZero() {
+		t.Errorf("expect zero time got '%s'", v.LastReported)
+	}
+
+	if s.VehicleTypes[0] != DefaultVehicleType {
+		t.Errorf("expect '%v' got '%v'", DefaultVehicleType, s.VehicleTypes[0])
+	}
+
+	if s.Plans[0].Price != 2.5 || s.Plans[1].Price != 0 {
+		t.Errorf("expect '2.5, 0' got '%f, %f'", s.Plans[0].Price, s.Plans[1].Price)
+	}
+
+	a := s.Alerts[0]
+	if a.Type != "station_closure" || !a.Times[0].End.IsZero() || !a.Times[0].Start.Equal(time.Unix(1589230640, 0)) {
+		t.Errorf("expect station_closure alert without end got '%v'", a)
+	}
+
+	if s.Zones != nil {
+		t.Errorf("expect 'nil' got '%v'", s.Zones)
+	}
+}
+
+func TestV20_ConvertMissingSystemInformation(t *testing.T) {
+	var c Converter = V20{}
+
+	if _, err := c.Convert(); !errors.Is(err, gbfs.ErrFeedNotExist) {
+		t.Errorf("expect '%s' got '%s'", gbfs.ErrFeedNotExist, err)
+	}
+}
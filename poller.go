@@ -0,0 +1,222 @@
+package gbfs
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"time"
+)
+
+type (
+	// PollerOption for Poller
+	PollerOption func(*Poller)
+
+	// PollerUpdate is delivered for each new version of a feed, or each failed fetch
+	PollerUpdate struct {
+		// Key of the feed
+		Key string
+
+		// New version of the feed, nil when Err is set
+		Feed Feed
+
+		// Error of the fetch
+		Err error
+	}
+
+	// Poller fetch feeds in background, each one when its TTL expires, and deliver the new versions
+	Poller struct {
+		client Client
+		feeds  []Feed
+
+		minInterval time.Duration
+		maxInterval time.Duration
+
+		updates chan PollerUpdate
+	}
+
+	// expirer is implemented by the feeds who know when they will be updated (see gbfsspec.Metadata)
+	expirer interface {
+		ExpireAt() time.Time
+	}
+)
+
+// Default minimum interval between two fetches of the same feed
+const defaultPollerMinInterval = 10 * time.Second
+
+// NewPoller return a poller for the feeds, a new structure of the same type of each feed is delivered for each version
+func NewPoller(c Client, feeds []Feed, opts ...PollerOption) *Poller {
+	p := &Poller{
+		client:      c,
+		feeds:       feeds,
+		minInterval: defaultPollerMinInterval,
+		updates:     make(chan PollerUpdate, len(feeds)),
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Updates return the channel where the new versions and the errors are delivered
+// The channel is closed when Run returns
+func (p *Poller) Updates() <-chan PollerUpdate {
+	return p.updates
+}
+
+// Run fetch the feeds until the context is done, it must be called only once
+// A feed is fetched again at last_updated + ttl, bounded by the intervals of the poller,
+// or after the minimum interval when the fetch failed.
+func (p *Poller) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for _, f := range p.feeds {
+		wg.Add(1)
+
+		go func(f Feed) {
+			defer wg.Done()
+			p.poll(ctx, f)
+		}(f)
+	}
+
+	wg.Wait()
+	close(p.updates)
+}
+
+// poll fetch the feed until the context is done
+// Once a version is delivered, a copy of it is refreshed: the client may respond ErrNotModified (see
+// HTTPOptionConditionalRequests) and nothing is delivered
+func (p *Poller) poll(ctx context.Context, like Feed) {
+	var (
+		last    Feed
+		lastExp time.Time
+	)
+
+	for {
+		var (
+			f   Feed
+			err error
+		)
+
+		if last != nil {
+			if f, err = copyFeed(last); err == nil {
+				err = p.client.RefreshContext(ctx, f, true)
+			}
+		} else if f, err = newFeed(like); err == nil {
+			err = p.client.GetContext(ctx, like.FeedKey(), f)
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		next := p.minInterval
+
+		switch {
+		case errors.Is(err, ErrNotModified):
+		case err != nil:
+			if !p.deliver(ctx, PollerUpdate{Key: like.FeedKey(), Err: err}) {
+				return
+			}
+		default:
+			exp, ok := expireAt(f)
+
+			// same last_updated and ttl, the provider didn't update the feed yet
+			if ok && exp.Equal(lastExp) {
+				break
+			}
+
+			if !p.deliver(ctx, PollerUpdate{Key: like.FeedKey(), Feed: f}) {
+				return
+			}
+
+			last = f
+
+			if ok {
+				lastExp = exp
+				next = p.delay(exp, time.Now())
+			}
+		}
+
+		if err := sleep(ctx, next); err != nil {
+			return
+		}
+	}
+}
+
+// deliver the update, return false when the context is done before
+func (p *Poller) deliver(ctx context.Context, u PollerUpdate) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case p.updates <- u:
+		return true
+	}
+}
+
+// delay return the duration until 'exp', bounded by the intervals of the poller
+func (p *Poller) delay(exp, now time.Time) time.Duration {
+	d := exp.Sub(now)
+
+	if d < p.minInterval {
+		d = p.minInterval
+	}
+
+	if p.maxInterval > 0 && d > p.maxInterval {
+		d = p.maxInterval
+	}
+
+	return d
+}
+
+// newFeed return a new structure of the same type of 'like'
+func newFeed(like Feed) (Feed, error) {
+	t := reflect.TypeOf(like)
+	if t.Kind() != reflect.Ptr {
+		return nil, ErrInvalidFeed
+	}
+
+	f, ok := reflect.New(t.Elem()).Interface().(Feed)
+	if !ok {
+		return nil, ErrInvalidFeed
+	}
+
+	return f, nil
+}
+
+// copyFeed return a shallow copy of the feed, the clients replace the feed refreshed instead of writing in it
+func copyFeed(f Feed) (Feed, error) {
+	c, err := newFeed(f)
+	if err != nil {
+		return nil, err
+	}
+
+	reflect.ValueOf(c).Elem().Set(reflect.ValueOf(f).Elem())
+
+	return c, nil
+}
+
+// expireAt return when the feed will be updated, if known
+func expireAt(f Feed) (time.Time, bool) {
+	e, ok := f.(expirer)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return e.ExpireAt(), true
+}
+
+// ==========
+//  OPTIONS
+// ==========
+
+// PollerOptionInterval specify the minimum and the maximum (0 for no limit) interval between two fetches of a feed
+// The minimum interval is used for the feeds with a TTL of 0, and after a failed fetch (10 seconds by default).
+func PollerOptionInterval(min, max time.Duration) PollerOption {
+	return func(p *Poller) {
+		p.minInterval = min
+		p.maxInterval = max
+	}
+}
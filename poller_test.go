@@ -0,0 +1,177 @@
+package gbfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+func TestPoller_Run(t *testing.T) {
+	fc := &fakeClient{ttl: 300}
+	p := NewPoller(fc, []Feed{&gbfsspec.FeedSystemInformation{}}, PollerOptionInterval(time.Millisecond, time.Minute))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go p.Run(ctx)
+
+	u := <-p.Updates()
+	cancel()
+
+	if u.Err != nil {
+		t.Errorf("expect 'nil' got '%s'", u.Err)
+	}
+
+	si, ok := u.Feed.(*gbfsspec.FeedSystemInformation)
+	if !ok {
+		t.Errorf("expect '*gbfsspec.FeedSystemInformation' got '%T'", u.Feed)
+		t.FailNow()
+	}
+
+	if si.Data.Name != "Bay Wheels" {
+		t.Errorf("expect 'Bay Wheels' got '%s'", si.Data.Name)
+	}
+
+	// channel is closed once stopped
+	for range p.Updates() {
+	}
+}
+
+func TestPoller_RunError(t *testing.T) {
+	fc := &fakeClient{err: ErrFeedNotExist}
+	p := NewPoller(fc, []Feed{&gbfsspec.FeedSystemInformation{}}, PollerOptionInterval(time.Millisecond, 0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go p.Run(ctx)
+
+	for n := 0; n < 2; n++ {
+		u := <-p.Updates()
+
+		if !errors.Is(u.Err, ErrFeedNotExist) {
+			t.Errorf("expect '%s' got '%s'", ErrFeedNotExist, u.Err)
+		}
+
+		if u.Key != gbfsspec.FeedKeySystemInformation {
+			t.Errorf("expect '%s' got '%s'", gbfsspec.FeedKeySystemInformation, u.Key)
+		}
+	}
+}
+
+func TestPoller_delay(t *testing.T) {
+	now := time.Unix(1589230640, 0)
+	p := NewPoller(nil, nil, PollerOptionInterval(10*time.Second, time.Minute))
+
+	ii := []struct {
+		in  time.Time
+		out time.Duration
+	}{
+		{in: now.Add(30 * time.Second), out: 30 * time.Second},
+		{in: now, out: 10 * time.Second},
+		{in: now.Add(-time.Hour), out: 10 * time.Second},
+		{in: now.Add(time.Hour), out: time.Minute},
+	}
+
+	for _, i := range ii {
+		if got := p.delay(i.in, now); got != i.out {
+			t.Errorf("expect '%s' got '%s'", i.out, got)
+		}
+	}
+}
+
+func TestPoller_RunConditionalRequests(t *testing.T) {
+	const etag = `"v1"`
+
+	var notModified int32
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", etag)
+		http.ServeFile(w, r, "test/gbfs/en/system_information.json")
+	}))
+	defer s.Close()
+
+	c, err := NewHTTPClient(HTTPOptionBaseURL(s.URL), HTTPOptionConditionalRequests())
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	p := NewPoller(c, []Feed{&gbfsspec.FeedSystemInformation{}}, PollerOptionInterval(time.Millisecond, 0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go p.Run(ctx)
+
+	if u := <-p.Updates(); u.Err != nil {
+		t.Errorf("expect 'nil' got '%s'", u.Err)
+	}
+
+	// the next fetches are not modified, nothing is delivered
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	for u := range p.Updates() {
+		t.Errorf("expect no update got '%v'", u)
+	}
+
+	if n := atomic.LoadInt32(&notModified); n == 0 {
+		t.Errorf("expect conditional requests got '0'")
+	}
+}
+
+func TestPoller_RunKeepDelivered(t *testing.T) {
+	var version int64
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := atomic.AddInt64(&version, 1)
+		_, _ = fmt.Fprintf(w, `{"last_updated": %d, "ttl": 0, "data": {"stations": [{"station_id": "%d"}]}}`, v, v)
+	}))
+	defer s.Close()
+
+	for _, cached := range []bool{false, true} {
+		c, err := NewHTTPClient(HTTPOptionBaseURL(s.URL))
+		if err != nil {
+			t.Errorf("expect 'nil' got '%s'", err)
+			t.FailNow()
+		}
+
+		if cached {
+			c = NewCachingClient(c)
+		}
+
+		p := NewPoller(c, []Feed{&gbfsspec.FeedStationStatus{}}, PollerOptionInterval(time.Millisecond, 0))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go p.Run(ctx)
+
+		first := (<-p.Updates()).Feed.(*gbfsspec.FeedStationStatus)
+		id := first.Data.Stations[0].StationID
+
+		// the next versions are decoded in new structures
+		for n := 0; n < 3; n++ {
+			if u := <-p.Updates(); u.Err != nil {
+				t.Errorf("expect 'nil' got '%s'", u.Err)
+			}
+		}
+
+		cancel()
+
+		for range p.Updates() {
+		}
+
+		if got := first.Data.Stations[0].StationID; got != id {
+			t.Errorf("expect '%s' got '%s'", id, got)
+		}
+	}
+}
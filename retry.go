@@ -0,0 +1,123 @@
+package gbfs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+type (
+	// RetryPolicy define how HTTPClient retry a failed fetch
+	// Transient network errors (see isRetryable), 5xx and 429 status codes are retried, a 404 (ErrFeedNotExist)
+	// or a TLS error are never retried
+	RetryPolicy struct {
+		// Maximum number of attempts, including the first one. Lower than 2 disable the retry.
+		MaxAttempts int
+
+		// Delay before the first retry, doubled after each attempt.
+		BaseDelay time.Duration
+
+		// Maximum delay between two attempts (0 for no limit).
+		// When the provider ask (via Retry-After) to wait longer, the error is returned without retry.
+		MaxDelay time.Duration
+
+		// Fraction of the delay (between 0 and 1) that is randomized, to avoid many clients retrying at the same time.
+		Jitter float64
+	}
+)
+
+// DefaultRetryPolicy is a sensible policy for most of the providers
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+	Jitter:      0.5,
+}
+
+// backoff return the delay to wait before the attempt 'attempt' (the first retry is the attempt 1)
+// and false when the policy doesn't allow to wait that long
+func (p RetryPolicy) backoff(attempt int, retryAfter time.Duration) (time.Duration, bool) {
+	d := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay == 0 || d < p.MaxDelay); i++ {
+		d *= 2
+	}
+
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		d -= time.Duration(float64(d) * p.Jitter * rand.Float64())
+	}
+
+	if retryAfter > d {
+		if p.MaxDelay > 0 && retryAfter > p.MaxDelay {
+			return 0, false
+		}
+
+		d = retryAfter
+	}
+
+	return d, true
+}
+
+// isRetryable return true when the error is likely to be transient: 429 and 5xx responses, timeouts,
+// connections refused or reset, and responses cut before their end
+func isRetryable(err error) bool {
+	if isContextError(err) {
+		return false
+	}
+
+	var herr *HTTPError
+	if errors.As(err, &herr) {
+		return herr.StatusCode == http.StatusTooManyRequests || herr.StatusCode >= http.StatusInternalServerError
+	}
+
+	var nerr net.Error
+	if errors.As(err, &nerr) && nerr.Timeout() {
+		return true
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	// the connection was closed by the provider before the response
+	var uerr *url.Error
+
+	return errors.As(err, &uerr) && errors.Is(uerr.Err, io.EOF)
+}
+
+// retryAfter return the delay asked by the provider, if any
+func retryAfter(err error) time.Duration {
+	var herr *HTTPError
+	if errors.As(err, &herr) {
+		return herr.RetryAfter
+	}
+
+	return 0
+}
+
+// isContextError return true when the error come from a canceled or expired context
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// sleep wait for the duration, or return the error of the context if done before
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,217 @@
+package gbfs
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	ii := []struct {
+		attempt    int
+		retryAfter time.Duration
+		out        time.Duration
+		ok         bool
+	}{
+		{attempt: 1, out: time.Second, ok: true},
+		{attempt: 2, out: 2 * time.Second, ok: true},
+		{attempt: 3, out: 4 * time.Second, ok: true},
+		{attempt: 4, out: 5 * time.Second, ok: true},
+		{attempt: 100, out: 5 * time.Second, ok: true},
+		{attempt: 1, retryAfter: 3 * time.Second, out: 3 * time.Second, ok: true},
+		{attempt: 1, retryAfter: time.Minute, out: 0, ok: false},
+	}
+
+	for _, i := range ii {
+		d, ok := p.backoff(i.attempt, i.retryAfter)
+		if d != i.out || ok != i.ok {
+			t.Errorf("expect '%s, %t' got '%s, %t' for attempt '%d'", i.out, i.ok, d, ok, i.attempt)
+		}
+	}
+}
+
+func TestRetryPolicy_backoffJitter(t *testing.T) {
+	p := RetryPolicy{BaseDelay: time.Second, Jitter: 0.5}
+
+	for n := 0; n < 100; n++ {
+		d, _ := p.backoff(1, 0)
+		if d < 500*time.Millisecond || d > time.Second {
+			t.Errorf("expect delay between '500ms' and '1s' got '%s'", d)
+		}
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	ii := []struct {
+		in  error
+		out bool
+	}{
+		{in: &HTTPError{StatusCode: http.StatusServiceUnavailable}, out: true},
+		{in: &HTTPError{StatusCode: http.StatusTooManyRequests}, out: true},
+		{in: &HTTPError{StatusCode: http.StatusNotFound}, out: false},
+		{in: &HTTPError{StatusCode: http.StatusForbidden}, out: false},
+		{in: fmt.Errorf("http.Do: %w", &url.Error{Op: "Get", Err: io.EOF}), out: true},
+		{in: fmt.Errorf("http.Do: %w", &url.Error{Op: "Get", Err: context.Canceled}), out: false},
+		{in: io.ErrUnexpectedEOF, out: true},
+		{in: fmt.Errorf("http.Do: %w", &url.Error{Op: "Get", Err: &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}}), out: true},
+		{in: fmt.Errorf("http.Do: %w", &url.Error{Op: "Get", Err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}), out: true},
+		{in: fmt.Errorf("http.Do: %w", &url.Error{Op: "Get", Err: &net.DNSError{Err: "timeout", IsTimeout: true}}), out: true},
+		{in: fmt.Errorf("http.Do: %w", &url.Error{Op: "Get", Err: &net.DNSError{Err: "no such host", IsNotFound: true}}), out: false},
+		{in: fmt.Errorf("http.Do: %w", &url.Error{Op: "Get", Err: x509.UnknownAuthorityError{}}), out: false},
+		{in: fmt.Errorf("http.Do: %w", &url.Error{Op: "Get", Err: errors.New("unsupported protocol scheme \"ftp\"")}), out: false},
+		{in: io.EOF, out: false},
+		{in: ErrInvalidFeed, out: false},
+	}
+
+	for _, i := range ii {
+		if got := isRetryable(i.in); got != i.out {
+			t.Errorf("expect '%t' got '%t' for '%s'", i.out, got, i.in)
+		}
+	}
+}
+
+func TestHTTPClient_GetRetry(t *testing.T) {
+	var calls int
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		switch {
+		case r.URL.Path == "/station_status.json":
+			w.WriteHeader(http.StatusNotFound)
+		case calls < 3:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			http.ServeFile(w, r, "test/gbfs/en/system_information.json")
+		}
+	}))
+	defer s.Close()
+
+	c, err := NewHTTPClient(
+		HTTPOptionBaseURL(s.URL),
+		HTTPOptionRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+	)
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	var si gbfsspec.FeedSystemInformation
+	if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("expect '3' got '%d'", calls)
+	}
+
+	calls = 0
+
+	var ss gbfsspec.FeedStationStatus
+	if err := c.Get(gbfsspec.FeedKeyStationStatus, &ss); !errors.Is(err, ErrFeedNotExist) {
+		t.Errorf("expect '%s' got '%s'", ErrFeedNotExist, err)
+	}
+
+	if calls != 1 {
+		t.Errorf("expect '1' got '%d'", calls)
+	}
+}
+
+func TestHTTPClient_GetRetryUnsupportedScheme(t *testing.T) {
+	c, err := NewHTTPClient(
+		HTTPOptionBaseURL("ftp://gbfs.example.com"),
+		HTTPOptionRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour}),
+	)
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	// retried, the fetch would wait the base delay
+	done := make(chan error, 1)
+	go func() {
+		var si gbfsspec.FeedSystemInformation
+		done <- c.Get(gbfsspec.FeedKeySystemInformation, &si)
+	}()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Errorf("expect error got 'nil'")
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("expect the fetch to not be retried")
+	}
+}
+
+func TestHTTPClient_GetRetryCanceled(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer s.Close()
+
+	c, err := NewHTTPClient(
+		HTTPOptionBaseURL(s.URL),
+		HTTPOptionRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour}),
+	)
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	// canceled during the wait before the second attempt
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var si gbfsspec.FeedSystemInformation
+	err = c.GetContext(ctx, gbfsspec.FeedKeySystemInformation, &si)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expect '%s' got '%v'", context.DeadlineExceeded, err)
+	}
+}
+
+func TestHTTPClient_GetRetryExhausted(t *testing.T) {
+	var calls int
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer s.Close()
+
+	c, err := NewHTTPClient(
+		HTTPOptionBaseURL(s.URL),
+		HTTPOptionRetry(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}),
+	)
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	var si gbfsspec.FeedSystemInformation
+	err = c.Get(gbfsspec.FeedKeySystemInformation, &si)
+
+	var herr *HTTPError
+	if !errors.As(err, &herr) || herr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expect '503' got '%s'", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("expect '2' got '%d'", calls)
+	}
+}
@@ -0,0 +1,266 @@
+package gbfs
+
+import (
+	"math"
+	"sort"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+type (
+	// BBox is a bounding box in degrees, crossing the antimeridian when MinLon is greater than MaxLon
+	BBox struct {
+		MinLat float64
+		MinLon float64
+		MaxLat float64
+		MaxLon float64
+	}
+
+	// StationFilter select the stations of a query, nil select all the stations
+	StationFilter func(s Station) bool
+
+	// BikeFilter select the bikes of a query, nil select all the bikes
+	BikeFilter func(b gbfsspec.FreeBikeStatus) bool
+
+	// NearbyStation is a station with its distance in meters to the position of the query
+	NearbyStation struct {
+		Station
+		Distance float64
+	}
+
+	// NearbyBike is a bike with its distance in meters to the position of the query
+	NearbyBike struct {
+		gbfsspec.FreeBikeStatus
+		Distance float64
+	}
+
+	// StationIndex answer the spatial queries over a snapshot of the stations
+	StationIndex struct {
+		stations []Station
+		tree     kdTree
+	}
+
+	// BikeIndex answer the spatial queries over a snapshot of the free bikes
+	BikeIndex struct {
+		bikes []gbfsspec.FreeBikeStatus
+		tree  kdTree
+	}
+)
+
+// Contains return true when the position is in the box
+func (b BBox) Contains(lat, lon float64) bool {
+	if lat < b.MinLat || lat > b.MaxLat {
+		return false
+	}
+
+	if b.MinLon <= b.MaxLon {
+		return lon >= b.MinLon && lon <= b.MaxLon
+	}
+
+	return lon >= b.MinLon || lon <= b.MaxLon
+}
+
+// circle return the center of the box and the radius in meters of a circle containing it,
+// false when the box is wider than 180 degrees of longitude
+func (b BBox) circle() (lat, lon, radius float64, ok bool) {
+	width := b.MaxLon - b.MinLon
+	if width < 0 {
+		width += 360
+	}
+
+	// the farthest points of the box from its center are its corners until 180 degrees
+	if width > 180 {
+		return 0, 0, 0, false
+	}
+
+	lat, lon = (b.MinLat+b.MaxLat)/2, b.MinLon+width/2
+	if lon > 180 {
+		lon -= 360
+	}
+
+	for _, c := range [][2]float64{{b.MinLat, b.MinLon}, {b.MinLat, b.MaxLon}, {b.MaxLat, b.MinLon}, {b.MaxLat, b.MaxLon}} {
+		radius = math.Max(radius, Distance(lat, lon, c[0], c[1]))
+	}
+
+	return lat, lon, radius, true
+}
+
+// StationIsRenting select the stations with a status who rent bikes
+func StationIsRenting() StationFilter {
+	return func(s Station) bool { return s.HasStatus && s.IsInstalled && s.IsRenting }
+}
+
+// StationIsReturning select the stations with a status who accept bike returns
+func StationIsReturning() StationFilter {
+	return func(s Station) bool { return s.HasStatus && s.IsInstalled && s.IsReturning }
+}
+
+// StationBikesAvailable select the stations with at least 'n' bikes available
+func StationBikesAvailable(n int) StationFilter {
+	return func(s Station) bool { return s.HasStatus && s.NumBikesAvailable >= n }
+}
+
+// StationDocksAvailable select the stations with at least 'n' docks available
+func StationDocksAvailable(n int) StationFilter {
+	return func(s Station) bool { return s.HasStatus && s.NumDocksAvailable >= n }
+}
+
+// StationAll select the stations selected by every filter
+func StationAll(ff ...StationFilter) StationFilter {
+	return func(s Station) bool {
+		for _, f := range ff {
+			if f != nil && !f(s) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// BikeAvailable select the bikes neither reserved nor disabled
+func BikeAvailable() BikeFilter {
+	return func(b gbfsspec.FreeBikeStatus) bool { return !bool(b.IsReserved) && !bool(b.IsDisabled) }
+}
+
+// NewStationIndex return an index over the stations
+func NewStationIndex(ss []Station) *StationIndex {
+	points := make([][3]float64, len(ss))
+	for i, s := range ss {
+		points[i] = unitVector(s.Latitude, s.Longitude)
+	}
+
+	return &StationIndex{stations: ss, tree: newKDTree(points)}
+}
+
+// Index return an index over the current stations, not updated with the status
+func (ss *Stations) Index() *StationIndex {
+	return NewStationIndex(ss.All())
+}
+
+// Nearest return the 'k' stations the nearest of the position selected by the filter, the nearest first
+func (x *StationIndex) Nearest(lat, lon float64, k int, filter StationFilter) []NearbyStation {
+	return x.nearby(lat, lon, x.tree.nearest(unitVector(lat, lon), k, x.accept(filter)))
+}
+
+// Within return the stations at most at 'radius' meters of the position selected by the filter, the nearest first
+func (x *StationIndex) Within(lat, lon, radius float64, filter StationFilter) []NearbyStation {
+	nn := x.nearby(lat, lon, x.tree.within(unitVector(lat, lon), chord(radius), x.accept(filter)))
+
+	for len(nn) > 0 && nn[len(nn)-1].Distance > radius {
+		nn = nn[:len(nn)-1]
+	}
+
+	return nn
+}
+
+// InBBox return the stations in the box selected by the filter
+func (x *StationIndex) InBBox(b BBox, filter StationFilter) []Station {
+	accept := x.accept(filter)
+
+	var ss []Station
+	for _, i := range bboxCandidates(x.tree, b) {
+		s := x.stations[i]
+
+		if b.Contains(s.Latitude, s.Longitude) && accept(i) {
+			ss = append(ss, s)
+		}
+	}
+
+	return ss
+}
+
+func (x *StationIndex) accept(filter StationFilter) func(i int) bool {
+	return func(i int) bool { return filter == nil || filter(x.stations[i]) }
+}
+
+func (x *StationIndex) nearby(lat, lon float64, ii []int) []NearbyStation {
+	nn := make([]NearbyStation, 0, len(ii))
+	for _, i := range ii {
+		s := x.stations[i]
+		nn = append(nn, NearbyStation{Station: s, Distance: Distance(lat, lon, s.Latitude, s.Longitude)})
+	}
+
+	sort.SliceStable(nn, func(i, j int) bool { return nn[i].Distance < nn[j].Distance })
+
+	return nn
+}
+
+// NewBikeIndex return an index over the free bikes
+func NewBikeIndex(bb []gbfsspec.FreeBikeStatus) *BikeIndex {
+	points := make([][3]float64, len(bb))
+	for i, b := range bb {
+		points[i] = unitVector(b.Latitude, b.Longitude)
+	}
+
+	return &BikeIndex{bikes: bb, tree: newKDTree(points)}
+}
+
+// Nearest return the 'k' bikes the nearest of the position selected by the filter, the nearest first
+func (x *BikeIndex) Nearest(lat, lon float64, k int, filter BikeFilter) []NearbyBike {
+	return x.nearby(lat, lon, x.tree.nearest(unitVector(lat, lon), k, x.accept(filter)))
+}
+
+// Within return the bikes at most at 'radius' meters of the position selected by the filter, the nearest first
+func (x *BikeIndex) Within(lat, lon, radius float64, filter BikeFilter) []NearbyBike {
+	nn := x.nearby(lat, lon, x.tree.within(unitVector(lat, lon), chord(radius), x.accept(filter)))
+
+	for len(nn) > 0 && nn[len(nn)-1].Distance > radius {
+		nn = nn[:len(nn)-1]
+	}
+
+	return nn
+}
+
+// InBBox return the bikes in the box selected by the filter
+func (x *BikeIndex) InBBox(b BBox, filter BikeFilter) []gbfsspec.FreeBikeStatus {
+	accept := x.accept(filter)
+
+	var bb []gbfsspec.FreeBikeStatus
+	for _, i := range bboxCandidates(x.tree, b) {
+		bike := x.bikes[i]
+
+		if b.Contains(bike.Latitude, bike.Longitude) && accept(i) {
+			bb = append(bb, bike)
+		}
+	}
+
+	return bb
+}
+
+func (x *BikeIndex) accept(filter BikeFilter) func(i int) bool {
+	return func(i int) bool { return filter == nil || filter(x.bikes[i]) }
+}
+
+func (x *BikeIndex) nearby(lat, lon float64, ii []int) []NearbyBike {
+	nn := make([]NearbyBike, 0, len(ii))
+	for _, i := range ii {
+		b := x.bikes[i]
+		nn = append(nn, NearbyBike{FreeBikeStatus: b, Distance: Distance(lat, lon, b.Latitude, b.Longitude)})
+	}
+
+	sort.SliceStable(nn, func(i, j int) bool { return nn[i].Distance < nn[j].Distance })
+
+	return nn
+}
+
+// bboxCandidates return the indexes of the points in the circle containing the box (all the points for a box wider
+// than 180 degrees), in the order of the index
+func bboxCandidates(t kdTree, b BBox) []int {
+	all := func(int) bool { return true }
+
+	lat, lon, radius, ok := b.circle()
+	if !ok {
+		ii := make([]int, len(t.points))
+		for i := range ii {
+			ii[i] = i
+		}
+
+		return ii
+	}
+
+	ii := t.within(unitVector(lat, lon), chord(radius), all)
+	sort.Ints(ii)
+
+	return ii
+}
@@ -0,0 +1,119 @@
+package gbfs
+
+import (
+	"testing"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+var spatialStations = []Station{
+	{ID: "market", Latitude: 37.7946, Longitude: -122.3950, HasStatus: true, IsInstalled: true, IsRenting: true, NumBikesAvailable: 1},
+	{ID: "mission", Latitude: 37.7890, Longitude: -122.4010, HasStatus: true, IsInstalled: true, IsRenting: true, NumBikesAvailable: 5},
+	{ID: "howard", Latitude: 37.7860, Longitude: -122.3970, HasStatus: true, IsInstalled: true, IsRenting: false, NumBikesAvailable: 8},
+	{ID: "oakland", Latitude: 37.8044, Longitude: -122.2712},
+}
+
+func stationIDs(nn []NearbyStation) string {
+	ids := ""
+	for _, n := range nn {
+		ids += n.ID + " "
+	}
+
+	return ids
+}
+
+func TestStationIndex_Nearest(t *testing.T) {
+	x := NewStationIndex(spatialStations)
+
+	ii := []struct {
+		k      int
+		filter StationFilter
+		out    string
+	}{
+		{k: 2, out: "market mission "},
+		{k: 10, out: "market mission howard oakland "},
+		{k: 1, filter: StationAll(StationIsRenting(), StationBikesAvailable(2)), out: "mission "},
+		{k: 1, filter: StationBikesAvailable(6), out: "howard "},
+		{k: 0, out: ""},
+	}
+
+	for _, i := range ii {
+		if o := stationIDs(x.Nearest(37.7950, -122.3945, i.k, i.filter)); o != i.out {
+			t.Errorf("expect '%s' got '%s'", i.out, o)
+		}
+	}
+}
+
+func TestStationIndex_Within(t *testing.T) {
+	x := NewStationIndex(spatialStations)
+
+	nn := x.Within(37.7950, -122.3945, 1200, nil)
+	if o := stationIDs(nn); o != "market mission howard " {
+		t.Errorf("expect 'market mission howard ' got '%s'", o)
+	}
+
+	if nn[0].Distance > 100 {
+		t.Errorf("expect less than '100' got '%f'", nn[0].Distance)
+	}
+
+	if o := stationIDs(x.Within(37.7950, -122.3945, 10, nil)); o != "" {
+		t.Errorf("expect '' got '%s'", o)
+	}
+}
+
+func TestStationIndex_InBBox(t *testing.T) {
+	x := NewStationIndex(spatialStations)
+
+	ss := x.InBBox(BBox{MinLat: 37.78, MinLon: -122.40, MaxLat: 37.80, MaxLon: -122.39}, StationIsRenting())
+	if len(ss) != 1 || ss[0].ID != "market" {
+		t.Errorf("expect 'market' got '%v'", ss)
+	}
+
+	// wider than 180 degrees
+	if ss := x.InBBox(BBox{MinLat: -90, MinLon: -170, MaxLat: 90, MaxLon: 170}, nil); len(ss) != 4 {
+		t.Errorf("expect '4' got '%d'", len(ss))
+	}
+}
+
+func TestBBox_Contains(t *testing.T) {
+	antimeridian := BBox{MinLat: -10, MinLon: 170, MaxLat: 10, MaxLon: -170}
+
+	ii := []struct {
+		lat, lon float64
+		out      bool
+	}{
+		{lat: 0, lon: 175, out: true},
+		{lat: 0, lon: -175, out: true},
+		{lat: 0, lon: 0, out: false},
+		{lat: 20, lon: 175, out: false},
+	}
+
+	for _, i := range ii {
+		if o := antimeridian.Contains(i.lat, i.lon); o != i.out {
+			t.Errorf("expect '%t' got '%t' for '%f, %f'", i.out, o, i.lat, i.lon)
+		}
+	}
+}
+
+func TestBikeIndex(t *testing.T) {
+	x := NewBikeIndex([]gbfsspec.FreeBikeStatus{
+		{BikeID: "b1", Latitude: 0.001, Longitude: 179.999},
+		{BikeID: "b2", Latitude: 0, Longitude: -179.999, IsReserved: true},
+		{BikeID: "b3", Latitude: 10, Longitude: 10},
+	})
+
+	nn := x.Within(0, 180, 300, nil)
+	if len(nn) != 2 {
+		t.Errorf("expect '2' got '%d'", len(nn))
+	}
+
+	nn = x.Nearest(0, -179.9, 1, BikeAvailable())
+	if len(nn) != 1 || nn[0].BikeID != "b1" {
+		t.Errorf("expect 'b1' got '%v'", nn)
+	}
+
+	bb := x.InBBox(BBox{MinLat: -1, MinLon: 179, MaxLat: 1, MaxLon: -179}, nil)
+	if len(bb) != 2 || bb[0].BikeID != "b1" || bb[1].BikeID != "b2" {
+		t.Errorf("expect 'b1, b2' got '%v'", bb)
+	}
+}
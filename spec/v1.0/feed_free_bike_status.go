@@ -0,0 +1,35 @@
+package gbfsspec
+
+type (
+	FeedFreeBikeStatus struct {
+		Metadata
+
+		Data FreeBikeStatusData `json:"data"`
+	}
+
+	FreeBikeStatusData struct {
+		// Array that contains one object per bike that is currently stopped, outside of a station.
+		Bikes []FreeBikeStatus `json:"bikes"`
+	}
+
+	FreeBikeStatus struct {
+		// Identifier of a bike. The version 1.0 doesn't require to rotate it after each trip.
+		BikeID string `json:"bike_id"`
+
+		// Latitude of the bike.
+		Latitude float64 `json:"lat"`
+
+		// Longitude of the bike.
+		Longitude float64 `json:"lon"`
+
+		// Is the bike currently reserved?
+		IsReserved Boolean `json:"is_reserved"`
+
+		// Is the bike currently disabled (broken)?
+		IsDisabled Boolean `json:"is_disabled"`
+	}
+)
+
+func (_ FeedFreeBikeStatus) FeedKey() string {
+	return FeedKeyFreeBikeStatus
+}
@@ -0,0 +1,34 @@
+package gbfsspec
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFeedSystemCalendars_FeedKey(t *testing.T) {
+	var f FeedSystemCalendars
+
+	if k := f.FeedKey(); k != FeedKeySystemCalendar {
+		t.Errorf("expect '%s' got '%s'", FeedKeySystemCalendar, k)
+	}
+}
+
+func TestFeedSystemCalendars_UnmarshalJSON(t *testing.T) {
+	in := `{"last_updated": 1434054678, "ttl": 0, "data": {"calendars": [
+		{"start_month": 4, "start_day": 1, "end_month": 11, "end_day": 30}]}}`
+
+	var f FeedSystemCalendars
+	if err := json.Unmarshal([]byte(in), &f); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if l := len(f.Data.Calendars); l != 1 {
+		t.Errorf("expect '1' got '%d'", l)
+		t.FailNow()
+	}
+
+	if m := f.Data.Calendars[0].EndMonth; m != 11 {
+		t.Errorf("expect '11' got '%d'", m)
+	}
+}
@@ -0,0 +1,59 @@
+package gbfsspec
+
+import "time"
+
+type (
+	FeedSystemInformation struct {
+		Metadata
+
+		Data SystemInformationData `json:"data"`
+	}
+
+	SystemInformationData struct {
+		// Identifier for this bike share system. This should be globally unique (even between different systems) -
+		// for example, bcycle_austin or biketown_pdx. It is up to the publisher of the feed to guarantee uniqueness.
+		SystemID string `json:"system_id"`
+
+		// The language that will be used throughout the rest of the files.
+		// It must match the value in the gbfs.json file.
+		Language string `json:"language"`
+
+		// Name of the system to be displayed to customers.
+		Name string `json:"name"`
+
+		// Optional abbreviation for a system.
+		ShortName string `json:"short_name,omitempty"`
+
+		// Name of the operator.
+		Operator string `json:"operator,omitempty"`
+
+		// The URL of the bike share system.
+		URL string `json:"url,omitempty"`
+
+		// URL where a customer can purchase a membership.
+		PurchaseURL string `json:"purchase_url,omitempty"`
+
+		// Date that the system began operations.
+		StartDate Date `json:"start_date,omitempty"`
+
+		// A single voice telephone number for the specified system.
+		PhoneNumber string `json:"phone_number,omitempty"`
+
+		// A single contact email address for customers to address questions about the system.
+		Email string `json:"email,omitempty"`
+
+		// The time zone where the system is located.
+		Timezone string `json:"timezone"`
+
+		// A fully qualified URL of a page that defines the license terms for the GBFS data for this system.
+		LicenseURL string `json:"license_url,omitempty"`
+	}
+)
+
+func (_ FeedSystemInformation) FeedKey() string {
+	return FeedKeySystemInformation
+}
+
+func (s SystemInformationData) GetStartDate() (time.Time, error) {
+	return s.StartDate.ToTime(s.Timezone)
+}
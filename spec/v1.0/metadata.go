@@ -0,0 +1,58 @@
+package gbfsspec
+
+import (
+	"time"
+
+	spec20 "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+type (
+	// Metadata of the feeds, the version 1.0 of the spec doesn't have the version field
+	Metadata struct {
+		// Last time the data in the feed was updated.
+		LastUpdated Timestamp `json:"last_updated"`
+
+		// Number of seconds before the data in the feed will be updated again (0 if the data should always be refreshed).
+		TTL int `json:"ttl"`
+
+		// Warnings of the strict or lenient decoding, never encoded
+		// Behind a pointer to keep the metadata (and the feeds) comparable
+		decodeWarnings *[]DecodeWarning
+	}
+)
+
+// DecodeWarning is a difference between the JSON of a feed and its structure, reported by a strict or lenient decoding
+type DecodeWarning = spec20.DecodeWarning
+
+// IsExpired return true if TTL has been reached
+func (m Metadata) IsExpired() bool {
+	if m.TTL == 0 {
+		return true
+	}
+
+	return time.Now().Unix() > int64(m.LastUpdated)+int64(m.TTL)
+}
+
+// ExpireAt return the time the feed will be updated again (last_updated + ttl)
+func (m Metadata) ExpireAt() time.Time {
+	return time.Unix(int64(m.LastUpdated)+int64(m.TTL), 0)
+}
+
+// DecodeWarnings return the warnings of the strict or lenient decoding of the feed
+func (m Metadata) DecodeWarnings() []DecodeWarning {
+	if m.decodeWarnings == nil {
+		return nil
+	}
+
+	return *m.decodeWarnings
+}
+
+// SetDecodeWarnings set the warnings of the strict or lenient decoding of the feed
+func (m *Metadata) SetDecodeWarnings(ww []DecodeWarning) {
+	if ww == nil {
+		m.decodeWarnings = nil
+		return
+	}
+
+	m.decodeWarnings = &ww
+}
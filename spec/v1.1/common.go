@@ -0,0 +1,33 @@
+package gbfsspec
+
+import spec20 "github.com/Eraac/gbfs/spec/v2.0"
+
+// Enum from the spec, shared with the others versions
+type (
+	AlertType    = spec20.AlertType
+	UserType     = spec20.UserType
+	Day          = spec20.Day
+	RentalMethod = spec20.RentalMethod
+)
+
+// Types shared with the others versions
+type (
+	// Service day in the YYYY-MM-DD format. Example: 2019-09-13 for September 13th, 2019.
+	Date = spec20.Date
+
+	// Service hour in the HH:MM:SS format. Example 22:26:02 or 02:16:00
+	Time = spec20.Time
+
+	Timestamp = spec20.Timestamp
+
+	Price   = spec20.Price
+	Boolean = spec20.Boolean
+
+	// Rental URIs for Android, iOS, and web
+	RentalURIs = spec20.RentalURIs
+)
+
+const (
+	DateFormat = spec20.DateFormat
+	TimeFormat = spec20.TimeFormat
+)
@@ -0,0 +1,38 @@
+package gbfsspec
+
+type (
+	FeedFreeBikeStatus struct {
+		Metadata
+
+		Data FreeBikeStatusData `json:"data"`
+	}
+
+	FreeBikeStatusData struct {
+		// Array that contains one object per bike that is currently stopped, outside of a station.
+		Bikes []FreeBikeStatus `json:"bikes"`
+	}
+
+	FreeBikeStatus struct {
+		// Identifier of a bike. The version 1.1 doesn't require to rotate it after each trip.
+		BikeID string `json:"bike_id"`
+
+		// Latitude of the bike.
+		Latitude float64 `json:"lat"`
+
+		// Longitude of the bike.
+		Longitude float64 `json:"lon"`
+
+		// Is the bike currently reserved?
+		IsReserved Boolean `json:"is_reserved"`
+
+		// Is the bike currently disabled (broken)?
+		IsDisabled Boolean `json:"is_disabled"`
+
+		// Contains rental URIs for Android, iOS, and web in the android, ios, and web fields.
+		RentalURIs RentalURIs `json:"rental_uris,omitempty"`
+	}
+)
+
+func (_ FeedFreeBikeStatus) FeedKey() string {
+	return FeedKeyFreeBikeStatus
+}
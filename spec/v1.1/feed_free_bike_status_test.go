@@ -0,0 +1,35 @@
+package gbfsspec
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFeedFreeBikeStatus_FeedKey(t *testing.T) {
+	var f FeedFreeBikeStatus
+
+	if k := f.FeedKey(); k != FeedKeyFreeBikeStatus {
+		t.Errorf("expect '%s' got '%s'", FeedKeyFreeBikeStatus, k)
+	}
+}
+
+func TestFeedFreeBikeStatus_UnmarshalJSON(t *testing.T) {
+	in := `{"last_updated": 1434054678, "ttl": 0, "version": "1.1", "data": {"bikes": [{"bike_id": "ghi799",
+		"lat": 12.34, "lon": 56.78, "is_reserved": 0, "is_disabled": 0,
+		"rental_uris": {"android": "https://www.example.com/app?sid=1234567890&platform=android"}}]}}`
+
+	var f FeedFreeBikeStatus
+	if err := json.Unmarshal([]byte(in), &f); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if f.Version != Version {
+		t.Errorf("expect '%s' got '%s'", Version, f.Version)
+	}
+
+	b := f.Data.Bikes[0]
+	if b.RentalURIs.Android != "https://www.example.com/app?sid=1234567890&platform=android" {
+		t.Errorf("expect android rental uri got '%s'", b.RentalURIs.Android)
+	}
+}
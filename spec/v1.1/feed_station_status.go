@@ -0,0 +1,47 @@
+package gbfsspec
+
+type (
+	FeedStationStatus struct {
+		Metadata
+
+		Data StationStatusData `json:"data"`
+	}
+
+	StationStatusData struct {
+		// Array that contains one object per station in the system
+		Stations []StationStatus `json:"stations"`
+	}
+
+	StationStatus struct {
+		// Identifier of a station see station_information.
+		StationID string `json:"station_id"`
+
+		// Number of bikes available for rental.
+		NumBikesAvailable int `json:"num_bikes_available"`
+
+		// Number of disabled bikes at the station.
+		NumBikesDisabled int `json:"num_bikes_disabled,omitempty"`
+
+		// Number of docks accepting bike returns.
+		NumDocksAvailable int `json:"num_docks_available"`
+
+		// Number of empty but disabled dock points at the station.
+		NumDocksDisabled int `json:"num_docks_disabled,omitempty"`
+
+		// Is the station currently on the street? Sent as 1/0 in the version 1.1.
+		IsInstalled Boolean `json:"is_installed"`
+
+		// Is the station currently renting bikes? Sent as 1/0 in the version 1.1.
+		IsRenting Boolean `json:"is_renting"`
+
+		// Is the station accepting bike returns? Sent as 1/0 in the version 1.1.
+		IsReturning Boolean `json:"is_returning"`
+
+		// The last time this station reported its status.
+		LastReported Timestamp `json:"last_reported"`
+	}
+)
+
+func (_ FeedStationStatus) FeedKey() string {
+	return FeedKeyStationStatus
+}
@@ -0,0 +1,35 @@
+package gbfsspec
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFeedStationStatus_FeedKey(t *testing.T) {
+	var f FeedStationStatus
+
+	if k := f.FeedKey(); k != FeedKeyStationStatus {
+		t.Errorf("expect '%s' got '%s'", FeedKeyStationStatus, k)
+	}
+}
+
+func TestFeedStationStatus_UnmarshalJSON(t *testing.T) {
+	in := `{"last_updated": 1434054678, "ttl": 0, "data": {"stations": [{"station_id": "pga",
+		"num_bikes_available": 4, "num_docks_available": 12, "is_installed": 1, "is_renting": 1, "is_returning": 0,
+		"last_reported": 1434054678}]}}`
+
+	var f FeedStationStatus
+	if err := json.Unmarshal([]byte(in), &f); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	s := f.Data.Stations[0]
+	if !s.IsInstalled || !s.IsRenting || s.IsReturning {
+		t.Errorf("expect 'true, true, false' got '%t, %t, %t'", s.IsInstalled, s.IsRenting, s.IsReturning)
+	}
+
+	if s.NumDocksAvailable != 12 {
+		t.Errorf("expect '12' got '%d'", s.NumDocksAvailable)
+	}
+}
@@ -0,0 +1,6 @@
+package gbfsspec
+
+import spec20 "github.com/Eraac/gbfs/spec/v2.0"
+
+// Metadata of the feeds, the version field appeared in the version 1.1 of the spec
+type Metadata = spec20.Metadata
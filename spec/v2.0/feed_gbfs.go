@@ -1,5 +1,7 @@
 package gbfsspec
 
+import "encoding/json"
+
 type (
 	FeedGBFS struct {
 		Metadata
@@ -10,6 +12,7 @@ type (
 	GBFSData struct {
 		// The language that will be used throughout the rest of the files.
 		// It must match the value in the system_information.json file.
+		// In JSON, each language is a key of the data object (e.g. "data": {"en": {"feeds": [...]}})
 		Languages map[string]GBFSLanguage `json:"languages"`
 	}
 
@@ -34,3 +37,23 @@ type (
 func (_ FeedGBFS) FeedKey() string {
 	return FeedKeyAutoDiscovery
 }
+
+// UnmarshalJSON decode the languages, keyed directly in the data object as the spec define it,
+// or under a "languages" key
+func (d *GBFSData) UnmarshalJSON(bs []byte) error {
+	var wrapped struct {
+		Languages map[string]GBFSLanguage `json:"languages"`
+	}
+
+	if err := json.Unmarshal(bs, &wrapped); err == nil && wrapped.Languages != nil {
+		d.Languages = wrapped.Languages
+		return nil
+	}
+
+	return json.Unmarshal(bs, &d.Languages)
+}
+
+// MarshalJSON encode the languages as keys of the data object
+func (d GBFSData) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Languages)
+}
@@ -1,6 +1,9 @@
 package gbfsspec
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestFeedGBFS_FeedKey(t *testing.T) {
 	var f FeedGBFS
@@ -9,3 +12,42 @@ func TestFeedGBFS_FeedKey(t *testing.T) {
 		t.Errorf("expect '%s' got '%s'", FeedKeyAutoDiscovery, k)
 	}
 }
+
+func TestGBFSData_UnmarshalJSON(t *testing.T) {
+	ii := []string{
+		`{"en": {"feeds": [{"name": "system_information", "url": "https://domain.tld/en/system_information.json"}]}}`,
+		`{"languages": {"en": {"feeds": [{"name": "system_information", "url": "https://domain.tld/en/system_information.json"}]}}}`,
+	}
+
+	for _, i := range ii {
+		var d GBFSData
+		if err := json.Unmarshal([]byte(i), &d); err != nil {
+			t.Errorf("expect 'nil' got '%s'", err)
+			continue
+		}
+
+		feeds := d.Languages["en"].Feeds
+		if len(feeds) != 1 {
+			t.Errorf("expect '1' got '%d'", len(feeds))
+			continue
+		}
+
+		if feeds[0].Name != FeedKeySystemInformation {
+			t.Errorf("expect '%s' got '%s'", FeedKeySystemInformation, feeds[0].Name)
+		}
+	}
+}
+
+func TestGBFSData_MarshalJSON(t *testing.T) {
+	d := GBFSData{Languages: map[string]GBFSLanguage{"fr": {}}}
+
+	bs, err := json.Marshal(d)
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if string(bs) != `{"fr":{"feeds":null}}` {
+		t.Errorf("expect '%s' got '%s'", `{"fr":{"feeds":null}}`, bs)
+	}
+}
@@ -10,7 +10,7 @@ type (
 	SystemCalendarsData struct {
 		// Array of objects describing the system operational calendar. A minimum of one calendar object is required.
 		// If start and end dates are the same every year, then start_year and end_year should be omitted.
-		Calendars SystemCalendar `json:"calendars"`
+		Calendars []SystemCalendar `json:"calendars"`
 	}
 
 	SystemCalendar struct {
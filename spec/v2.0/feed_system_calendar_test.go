@@ -1,6 +1,9 @@
 package gbfsspec
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestFeedSystemCalendars_FeedKey(t *testing.T) {
 	var f FeedSystemCalendars
@@ -9,3 +12,25 @@ func TestFeedSystemCalendars_FeedKey(t *testing.T) {
 		t.Errorf("expect '%s' got '%s'", FeedKeySystemCalendar, k)
 	}
 }
+
+func TestFeedSystemCalendars_UnmarshalJSON(t *testing.T) {
+	raw := `{"last_updated": 1589230640, "ttl": 86400, "data": {"calendars": [
+		{"start_month": 4, "start_day": 1, "end_month": 11, "end_day": 30},
+		{"start_month": 12, "start_day": 15, "start_year": 2020, "end_month": 1, "end_day": 15, "end_year": 2021}
+	]}}`
+
+	var f FeedSystemCalendars
+	if err := json.Unmarshal([]byte(raw), &f); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if l := len(f.Data.Calendars); l != 2 {
+		t.Errorf("expect '2' got '%d'", l)
+		t.FailNow()
+	}
+
+	if c := f.Data.Calendars[1]; c.StartYear != 2020 || c.EndYear != 2021 {
+		t.Errorf("expect '2020, 2021' got '%d, %d'", c.StartYear, c.EndYear)
+	}
+}
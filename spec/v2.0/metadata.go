@@ -12,9 +12,39 @@ type (
 
 		// GBFS version number to which the feed confirms, according to the versioning framework.
 		Version string `json:"version"`
+
+		// Warnings of the strict or lenient decoding, never encoded
+		// Behind a pointer to keep the metadata (and the feeds) comparable
+		decodeWarnings *[]DecodeWarning
+	}
+
+	// DecodeWarning is a difference between the JSON of a feed and its structure, reported by a strict or lenient decoding
+	DecodeWarning struct {
+		// JSON path of the field (e.g. data.stations[2].lat)
+		Path string `json:"path"`
+
+		// One of the DecodeWarning constants
+		Kind string `json:"kind"`
+
+		Message string `json:"message"`
 	}
 )
 
+// Kinds of DecodeWarning
+const (
+	// The field is not in the structure, likely an extension of the spec or a typo
+	DecodeWarningUnknownField = "unknown_field"
+
+	// The field is required by the spec but absent from the JSON
+	DecodeWarningMissingField = "missing_field"
+
+	// The value has been converted to the type of the field (e.g. "45.5" to 45.5), by a lenient decoding
+	DecodeWarningCoercedValue = "coerced_value"
+
+	// The value can't be converted to the type of the field and has been ignored, by a lenient decoding
+	DecodeWarningInvalidValue = "invalid_value"
+)
+
 // IsExpired return true if TTL has been reached
 func (m Metadata) IsExpired() bool {
 	if m.TTL == 0 {
@@ -23,3 +53,27 @@ func (m Metadata) IsExpired() bool {
 
 	return time.Now().Unix() > int64(m.LastUpdated)+int64(m.TTL)
 }
+
+// ExpireAt return the time the feed will be updated again (last_updated + ttl)
+func (m Metadata) ExpireAt() time.Time {
+	return time.Unix(int64(m.LastUpdated)+int64(m.TTL), 0)
+}
+
+// DecodeWarnings return the warnings of the strict or lenient decoding of the feed
+func (m Metadata) DecodeWarnings() []DecodeWarning {
+	if m.decodeWarnings == nil {
+		return nil
+	}
+
+	return *m.decodeWarnings
+}
+
+// SetDecodeWarnings set the warnings of the strict or lenient decoding of the feed
+func (m *Metadata) SetDecodeWarnings(ww []DecodeWarning) {
+	if ww == nil {
+		m.decodeWarnings = nil
+		return
+	}
+
+	m.decodeWarnings = &ww
+}
@@ -30,3 +30,35 @@ func TestMetadata_IsExpired(t *testing.T) {
 		}
 	}
 }
+
+func TestMetadata_ExpireAt(t *testing.T) {
+	m := Metadata{LastUpdated: Timestamp(1589230640), TTL: 300}
+
+	if got := m.ExpireAt().Unix(); got != 1589230940 {
+		t.Errorf("expect '1589230940' got '%d'", got)
+	}
+}
+
+func TestMetadata_DecodeWarnings(t *testing.T) {
+	var m Metadata
+
+	if ww := m.DecodeWarnings(); ww != nil {
+		t.Errorf("expect 'nil' got '%v'", ww)
+	}
+
+	m.SetDecodeWarnings([]DecodeWarning{{Path: "data.x", Kind: DecodeWarningUnknownField}})
+
+	if ww := m.DecodeWarnings(); len(ww) != 1 || ww[0].Path != "data.x" {
+		t.Errorf("expect 'data.x' got '%v'", ww)
+	}
+
+	// the metadata stay comparable
+	c := m
+	if c != m {
+		t.Errorf("expect copies to be equal")
+	}
+
+	if (FeedSystemInformation{}) != (FeedSystemInformation{}) {
+		t.Errorf("expect empty feeds to be equal")
+	}
+}
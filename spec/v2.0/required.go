@@ -0,0 +1,50 @@
+package gbfsspec
+
+// RequiredRootFields are the fields required in the JSON of every feed
+// The version field is absent before 2.0 (decoded with the same structures), it's not required
+var RequiredRootFields = []string{"last_updated", "ttl", "data"}
+
+// RequiredFields are the fields required in the JSON of the feeds, by feed key then by path of the object
+// The elements of an array are noted with [] (e.g. data.stations[])
+var RequiredFields = map[string]map[string][]string{
+	FeedKeyGBFSVersions: {
+		"data":            {"versions"},
+		"data.versions[]": {"version", "url"},
+	},
+	FeedKeySystemInformation: {
+		"data": {"system_id", "language", "name", "timezone"},
+	},
+	FeedKeyStationInformation: {
+		"data":            {"stations"},
+		"data.stations[]": {"station_id", "name", "lat", "lon"},
+	},
+	FeedKeyStationStatus: {
+		"data":            {"stations"},
+		"data.stations[]": {"station_id", "num_bikes_available", "num_docks_available", "is_installed", "is_renting", "is_returning", "last_reported"},
+	},
+	FeedKeyFreeBikeStatus: {
+		"data":         {"bikes"},
+		"data.bikes[]": {"bike_id", "lat", "lon", "is_reserved", "is_disabled"},
+	},
+	FeedKeySystemHours: {
+		"data":                {"rental_hours"},
+		"data.rental_hours[]": {"user_types", "days", "start_time", "end_time"},
+	},
+	FeedKeySystemCalendar: {
+		"data":             {"calendars"},
+		"data.calendars[]": {"start_month", "start_day", "end_month", "end_day"},
+	},
+	FeedKeySystemRegions: {
+		"data":           {"regions"},
+		"data.regions[]": {"region_id", "name"},
+	},
+	FeedKeySystemPricingPlans: {
+		"data":         {"plans"},
+		"data.plans[]": {"plan_id", "name", "currency", "price", "is_taxable", "description"},
+	},
+	FeedKeySystemAlerts: {
+		"data":                  {"alerts"},
+		"data.alerts[]":         {"alert_id", "type", "summary"},
+		"data.alerts[].times[]": {"start"},
+	},
+}
@@ -0,0 +1,56 @@
+package gbfsspec
+
+type (
+	FeedFreeBikeStatus struct {
+		Metadata
+
+		Data FreeBikeStatusData `json:"data"`
+	}
+
+	FreeBikeStatusData struct {
+		// Array that contains one object per bike that is currently stopped as defined below.
+		Bikes []FreeBikeStatus `json:"bikes"`
+	}
+
+	FreeBikeStatus struct {
+		// Identifier of a bike, rotated to a random string, at minimum, after each trip to protect privacy.
+		BikeID string `json:"bike_id"`
+
+		// Latitude of the bike.
+		Latitude float64 `json:"lat"`
+
+		// Longitude of the bike.
+		Longitude float64 `json:"lon"`
+
+		// Is the bike currently reserved?
+		IsReserved Boolean `json:"is_reserved"`
+
+		// Is the bike currently disabled (broken)?
+		IsDisabled Boolean `json:"is_disabled"`
+
+		// Object that contains rental URIs for Android, iOS, and web in the android, ios, and web fields
+		RentalURIs RentalURIs `json:"rental_uris,omitempty"`
+
+		// The vehicle_type_id of this vehicle as described in vehicle_types.
+		// Required if the vehicle_types feed is defined.
+		VehicleTypeID string `json:"vehicle_type_id,omitempty"`
+
+		// The last time this vehicle reported its status to the operator's backend.
+		LastReported Timestamp `json:"last_reported,omitempty"`
+
+		// If the corresponding vehicle_type definition for this vehicle has a motor, then this field is required.
+		// This value represents the furthest distance in meters that the vehicle can travel without recharging
+		// or refueling with the vehicle's current charge or fuel.
+		CurrentRangeMeters float64 `json:"current_range_meters,omitempty"`
+
+		// Identifier referencing the station_id if the vehicle is currently at a station.
+		StationID string `json:"station_id,omitempty"`
+
+		// The plan_id of the pricing plan this vehicle is eligible for as described in system_pricing_plans.
+		PricingPlanID string `json:"pricing_plan_id,omitempty"`
+	}
+)
+
+func (_ FeedFreeBikeStatus) FeedKey() string {
+	return FeedKeyFreeBikeStatus
+}
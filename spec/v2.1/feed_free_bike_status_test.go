@@ -0,0 +1,39 @@
+package gbfsspec
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFeedFreeBikeStatus_FeedKey(t *testing.T) {
+	var f FeedFreeBikeStatus
+
+	if k := f.FeedKey(); k != FeedKeyFreeBikeStatus {
+		t.Errorf("expect '%s' got '%s'", FeedKeyFreeBikeStatus, k)
+	}
+}
+
+func TestFeedFreeBikeStatus_UnmarshalJSON(t *testing.T) {
+	in := `{"last_updated": 1609866247, "ttl": 0, "version": "2.1", "data": {"bikes": [{"bike_id": "ghi799",
+		"last_reported": 1609866204, "lat": 12.34, "lon": 56.78, "is_reserved": false, "is_disabled": false,
+		"vehicle_type_id": "def456", "current_range_meters": 6543}]}}`
+
+	var f FeedFreeBikeStatus
+	if err := json.Unmarshal([]byte(in), &f); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	b := f.Data.Bikes[0]
+	if b.VehicleTypeID != "def456" {
+		t.Errorf("expect 'def456' got '%s'", b.VehicleTypeID)
+	}
+
+	if b.CurrentRangeMeters != 6543 {
+		t.Errorf("expect '6543' got '%f'", b.CurrentRangeMeters)
+	}
+
+	if b.LastReported != 1609866204 {
+		t.Errorf("expect '1609866204' got '%d'", b.LastReported)
+	}
+}
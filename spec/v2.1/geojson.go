@@ -0,0 +1,13 @@
+package gbfsspec
+
+type (
+	// GeoJSON MultiPolygon geometry
+	MultiPolygon struct {
+		// Always "MultiPolygon".
+		Type string `json:"type"`
+
+		// Array of polygons, each one an array of linear rings (the first is the exterior ring, the others are holes),
+		// each ring an array of positions [longitude, latitude].
+		Coordinates [][][][]float64 `json:"coordinates"`
+	}
+)
@@ -0,0 +1,6 @@
+package gbfsspec
+
+import spec20 "github.com/Eraac/gbfs/spec/v2.0"
+
+// Metadata of the feeds, unchanged since the version 2.0 of the spec
+type Metadata = spec20.Metadata
@@ -0,0 +1,66 @@
+package gbfsspec
+
+const Version = "2.2"
+
+const (
+	FeedKeyAutoDiscovery      = "gbfs"
+	FeedKeyGBFSVersions       = "gbfs_versions"
+	FeedKeySystemInformation  = "system_information"
+	FeedKeyVehicleTypes       = "vehicle_types"
+	FeedKeyStationInformation = "station_information"
+	FeedKeyStationStatus      = "station_status"
+	FeedKeyFreeBikeStatus     = "free_bike_status"
+	FeedKeySystemHours        = "system_hours"
+	FeedKeySystemCalendar     = "system_calendar"
+	FeedKeySystemRegions      = "system_regions"
+	FeedKeySystemPricingPlans = "system_pricing_plans"
+	FeedKeySystemAlerts       = "system_alerts"
+)
+
+const (
+	RentalMethodKey           RentalMethod = "KEY"
+	RentalMethodCreditCard    RentalMethod = "CREDITCARD"
+	RentalMethodPayPass       RentalMethod = "PAYPASS"
+	RentalMethodApplePay      RentalMethod = "APPLEPAY"
+	RentalMethodAndroidPay    RentalMethod = "ANDROIDPAY"
+	RentalMethodTransitCard   RentalMethod = "TRANSITCARD"
+	RentalMethodAccountNumber RentalMethod = "ACCOUNTNUMBER"
+	RentalMethodPhone         RentalMethod = "PHONE"
+)
+
+const (
+	FormFactorBicycle FormFactor = "bicycle"
+	FormFactorCar     FormFactor = "car"
+	FormFactorMoped   FormFactor = "moped"
+	FormFactorScooter FormFactor = "scooter"
+	FormFactorOther   FormFactor = "other"
+)
+
+const (
+	PropulsionTypeHuman          PropulsionType = "human"
+	PropulsionTypeElectricAssist PropulsionType = "electric_assist"
+	PropulsionTypeElectric       PropulsionType = "electric"
+	PropulsionTypeCombustion     PropulsionType = "combustion"
+)
+
+const (
+	UserTypeMember    UserType = "member"
+	UserTypeNonMember UserType = "nonmember"
+)
+
+const (
+	DayMonday    Day = "mon"
+	DayTuesday   Day = "tue"
+	DayWednesday Day = "wed"
+	DayThursday  Day = "thu"
+	DayFriday    Day = "fri"
+	DaySaturday  Day = "sat"
+	DaySunday    Day = "sun"
+)
+
+const (
+	AlertTypeSystemClosure  AlertType = "SYSTEM_CLOSURE"
+	AlertTypeStationClosure AlertType = "STATION_CLOSURE"
+	AlertTypeStationMove    AlertType = "STATION_MOVE"
+	AlertTypeOther          AlertType = "OTHER"
+)
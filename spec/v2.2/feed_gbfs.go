@@ -0,0 +1,24 @@
+package gbfsspec
+
+import spec20 "github.com/Eraac/gbfs/spec/v2.0"
+
+type (
+	FeedGBFS struct {
+		Metadata
+
+		Data GBFSData `json:"data"`
+	}
+
+	// The languages of the auto-discovery feed, each one with the list of its feeds
+	GBFSData = spec20.GBFSData
+
+	// An array of all of the feeds that are published by this auto-discovery file.
+	GBFSLanguage = spec20.GBFSLanguage
+
+	// Name and URL of a feed.
+	GBFSFeed = spec20.GBFSFeed
+)
+
+func (_ FeedGBFS) FeedKey() string {
+	return FeedKeyAutoDiscovery
+}
@@ -0,0 +1,42 @@
+package gbfsspec
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFeedStationStatus_FeedKey(t *testing.T) {
+	var f FeedStationStatus
+
+	if k := f.FeedKey(); k != FeedKeyStationStatus {
+		t.Errorf("expect '%s' got '%s'", FeedKeyStationStatus, k)
+	}
+}
+
+func TestFeedStationStatus_UnmarshalJSON(t *testing.T) {
+	in := `{"last_updated": 1609866247, "ttl": 0, "version": "2.2", "data": {"stations": [{"station_id": "station1",
+		"is_installed": true, "is_renting": true, "is_returning": true, "last_reported": 1609866125,
+		"num_docks_available": 3, "vehicle_docks_available": [{"vehicle_type_ids": ["abc123"], "count": 2},
+		{"vehicle_type_ids": ["def456"], "count": 1}], "num_bikes_available": 1,
+		"vehicle_types_available": [{"vehicle_type_id": "abc123", "count": 1}, {"vehicle_type_id": "def456", "count": 0}]}]}}`
+
+	var f FeedStationStatus
+	if err := json.Unmarshal([]byte(in), &f); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	s := f.Data.Stations[0]
+	if l := len(s.VehicleTypesAvailable); l != 2 {
+		t.Errorf("expect '2' got '%d'", l)
+	}
+
+	if l := len(s.VehicleDocksAvailable); l != 2 {
+		t.Errorf("expect '2' got '%d'", l)
+		t.FailNow()
+	}
+
+	if d := s.VehicleDocksAvailable[0]; d.VehicleTypeIDs[0] != "abc123" || d.Count != 2 {
+		t.Errorf("expect 'abc123, 2' got '%s, %d'", d.VehicleTypeIDs[0], d.Count)
+	}
+}
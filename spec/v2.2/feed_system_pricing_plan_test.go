@@ -0,0 +1,48 @@
+package gbfsspec
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFeedSystemPricingPlans_FeedKey(t *testing.T) {
+	var f FeedSystemPricingPlans
+
+	if k := f.FeedKey(); k != FeedKeySystemPricingPlans {
+		t.Errorf("expect '%s' got '%s'", FeedKeySystemPricingPlans, k)
+	}
+}
+
+func TestFeedSystemPricingPlans_UnmarshalJSON(t *testing.T) {
+	in := `{"last_updated": 1609866247, "ttl": 0, "version": "2.2", "data": {"plans": [{"plan_id": "plan3",
+		"name": "Simple Rate", "currency": "CAD", "price": 3.00, "is_taxable": true,
+		"description": "$3 unlock fee, $0.25 per kilometer and 0.50 per minute.",
+		"per_km_pricing": [{"start": 0, "rate": 0.25, "interval": 1}],
+		"per_min_pricing": [{"start": 0, "rate": 0.50, "interval": 1, "end": 60}, {"start": 60, "rate": 0.30, "interval": 1}],
+		"surge_pricing": true}]}}`
+
+	var f FeedSystemPricingPlans
+	if err := json.Unmarshal([]byte(in), &f); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	p := f.Data.Plans[0]
+	if l := len(p.PerKmPricing); l != 1 {
+		t.Errorf("expect '1' got '%d'", l)
+	}
+
+	if l := len(p.PerMinPricing); l != 2 {
+		t.Errorf("expect '2' got '%d'", l)
+		t.FailNow()
+	}
+
+	want := SystemPricingSegment{Start: 0, Rate: 0.5, Interval: 1, End: 60}
+	if p.PerMinPricing[0] != want {
+		t.Errorf("expect '%v' got '%v'", want, p.PerMinPricing[0])
+	}
+
+	if !p.SurgePricing {
+		t.Errorf("expect 'true' got '%t'", p.SurgePricing)
+	}
+}
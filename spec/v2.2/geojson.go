@@ -0,0 +1,6 @@
+package gbfsspec
+
+import spec21 "github.com/Eraac/gbfs/spec/v2.1"
+
+// GeoJSON MultiPolygon geometry
+type MultiPolygon = spec21.MultiPolygon
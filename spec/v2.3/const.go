@@ -0,0 +1,82 @@
+package gbfsspec
+
+const Version = "2.3"
+
+const (
+	FeedKeyAutoDiscovery      = "gbfs"
+	FeedKeyGBFSVersions       = "gbfs_versions"
+	FeedKeySystemInformation  = "system_information"
+	FeedKeyVehicleTypes       = "vehicle_types"
+	FeedKeyStationInformation = "station_information"
+	FeedKeyStationStatus      = "station_status"
+	FeedKeyFreeBikeStatus     = "free_bike_status"
+	FeedKeySystemHours        = "system_hours"
+	FeedKeySystemCalendar     = "system_calendar"
+	FeedKeySystemRegions      = "system_regions"
+	FeedKeySystemPricingPlans = "system_pricing_plans"
+	FeedKeySystemAlerts       = "system_alerts"
+	FeedKeyGeofencingZones    = "geofencing_zones"
+)
+
+const (
+	RentalMethodKey           RentalMethod = "KEY"
+	RentalMethodCreditCard    RentalMethod = "CREDITCARD"
+	RentalMethodPayPass       RentalMethod = "PAYPASS"
+	RentalMethodApplePay      RentalMethod = "APPLEPAY"
+	RentalMethodAndroidPay    RentalMethod = "ANDROIDPAY"
+	RentalMethodTransitCard   RentalMethod = "TRANSITCARD"
+	RentalMethodAccountNumber RentalMethod = "ACCOUNTNUMBER"
+	RentalMethodPhone         RentalMethod = "PHONE"
+)
+
+const (
+	FormFactorBicycle         FormFactor = "bicycle"
+	FormFactorCargoBicycle    FormFactor = "cargo_bicycle"
+	FormFactorCar             FormFactor = "car"
+	FormFactorMoped           FormFactor = "moped"
+	FormFactorScooter         FormFactor = "scooter"
+	FormFactorScooterStanding FormFactor = "scooter_standing"
+	FormFactorScooterSeated   FormFactor = "scooter_seated"
+	FormFactorOther           FormFactor = "other"
+)
+
+const (
+	PropulsionTypeHuman            PropulsionType = "human"
+	PropulsionTypeElectricAssist   PropulsionType = "electric_assist"
+	PropulsionTypeElectric         PropulsionType = "electric"
+	PropulsionTypeCombustion       PropulsionType = "combustion"
+	PropulsionTypeCombustionDiesel PropulsionType = "combustion_diesel"
+	PropulsionTypeHybrid           PropulsionType = "hybrid"
+	PropulsionTypePlugInHybrid     PropulsionType = "plug_in_hybrid"
+	PropulsionTypeHydrogenFuelCell PropulsionType = "hydrogen_fuel_cell"
+)
+
+const (
+	ParkingTypeParkingLot         ParkingType = "parking_lot"
+	ParkingTypeStreetParking      ParkingType = "street_parking"
+	ParkingTypeUndergroundParking ParkingType = "underground_parking"
+	ParkingTypeSidewalkParking    ParkingType = "sidewalk_parking"
+	ParkingTypeOther              ParkingType = "other"
+)
+
+const (
+	UserTypeMember    UserType = "member"
+	UserTypeNonMember UserType = "nonmember"
+)
+
+const (
+	DayMonday    Day = "mon"
+	DayTuesday   Day = "tue"
+	DayWednesday Day = "wed"
+	DayThursday  Day = "thu"
+	DayFriday    Day = "fri"
+	DaySaturday  Day = "sat"
+	DaySunday    Day = "sun"
+)
+
+const (
+	AlertTypeSystemClosure  AlertType = "SYSTEM_CLOSURE"
+	AlertTypeStationClosure AlertType = "STATION_CLOSURE"
+	AlertTypeStationMove    AlertType = "STATION_MOVE"
+	AlertTypeOther          AlertType = "OTHER"
+)
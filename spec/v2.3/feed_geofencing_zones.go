@@ -0,0 +1,86 @@
+package gbfsspec
+
+type (
+	FeedGeofencingZones struct {
+		Metadata
+
+		Data GeofencingZonesData `json:"data"`
+	}
+
+	GeofencingZonesData struct {
+		// A FeatureCollection (as described by the IETF RFC 7946) where each feature describes a geofencing zone.
+		GeofencingZones GeofencingFeatureCollection `json:"geofencing_zones"`
+	}
+
+	GeofencingFeatureCollection struct {
+		// Always "FeatureCollection".
+		Type string `json:"type"`
+
+		// Array of features, one per geofencing zone.
+		Features []GeofencingZone `json:"features"`
+	}
+
+	GeofencingZone struct {
+		// Always "Feature".
+		Type string `json:"type"`
+
+		// A polygon that describes where rides might not be able to start, end, go through, or have other limitations.
+		// Rules for the area are defined in the properties.
+		Geometry MultiPolygon `json:"geometry"`
+
+		// Describing travel allowances and limitations.
+		Properties GeofencingZoneProperties `json:"properties"`
+	}
+
+	GeofencingZoneProperties struct {
+		// Public name of the geofencing zone.
+		Name string `json:"name,omitempty"`
+
+		// Start time of the geofencing zone. If the geofencing zone is always active, this can be omitted.
+		Start Timestamp `json:"start,omitempty"`
+
+		// End time of the geofencing zone. If the geofencing zone is always active, this can be omitted.
+		End Timestamp `json:"end,omitempty"`
+
+		// Array that contains one object per rule. In the event of overlapping rules within the same zone,
+		// the earlier rule (in order of the JSON file) takes precedence.
+		Rules []GeofencingZoneRule `json:"rules,omitempty"`
+	}
+
+	GeofencingZoneRule struct {
+		// Array of vehicle_type_id, as described in vehicle_types, to which these restrictions apply.
+		// If vehicle_type_ids are not specified, then these restrictions apply to all vehicle types.
+		VehicleTypeIDs []string `json:"vehicle_type_id,omitempty"`
+
+		// Is the undocked (“free bike”) ride allowed to start and end in this zone?
+		RideAllowed Boolean `json:"ride_allowed"`
+
+		// Is the ride allowed to travel through this zone?
+		RideThroughAllowed Boolean `json:"ride_through_allowed"`
+
+		// What is the maximum speed allowed, in kilometers per hour? 0 when there is no speed limit.
+		MaximumSpeedKph int `json:"maximum_speed_kph,omitempty"`
+
+		// Must the vehicle be parked at a station defined in station_information within this geofence zone?
+		StationParking Boolean `json:"station_parking,omitempty"`
+	}
+)
+
+func (_ FeedGeofencingZones) FeedKey() string {
+	return FeedKeyGeofencingZones
+}
+
+// AppliesTo return true when the rule applies to the vehicle type
+func (r GeofencingZoneRule) AppliesTo(vehicleTypeID string) bool {
+	if len(r.VehicleTypeIDs) == 0 {
+		return true
+	}
+
+	for _, id := range r.VehicleTypeIDs {
+		if id == vehicleTypeID {
+			return true
+		}
+	}
+
+	return false
+}
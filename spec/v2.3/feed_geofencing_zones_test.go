@@ -0,0 +1,68 @@
+package gbfsspec
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFeedGeofencingZones_FeedKey(t *testing.T) {
+	var f FeedGeofencingZones
+
+	if k := f.FeedKey(); k != FeedKeyGeofencingZones {
+		t.Errorf("expect '%s' got '%s'", FeedKeyGeofencingZones, k)
+	}
+}
+
+func TestFeedGeofencingZones_UnmarshalJSON(t *testing.T) {
+	in := `{"last_updated": 1604198100, "ttl": 60, "version": "2.3", "data": {"geofencing_zones": {
+		"type": "FeatureCollection", "features": [{"type": "Feature", "properties": {"name": "NE 24th/NE Knott",
+		"start": 1593878400, "end": 1593907260, "rules": [{"vehicle_type_id": ["moped1", "car1"], "ride_allowed": false,
+		"ride_through_allowed": true, "maximum_speed_kph": 10, "station_parking": true}]},
+		"geometry": {"type": "MultiPolygon", "coordinates": [[[[-122.655775, 45.516445], [-122.655705, 45.516445],
+		[-122.655705, 45.516495], [-122.655775, 45.516445]]]]}}]}}}`
+
+	var f FeedGeofencingZones
+	if err := json.Unmarshal([]byte(in), &f); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	zz := f.Data.GeofencingZones.Features
+	if l := len(zz); l != 1 {
+		t.Errorf("expect '1' got '%d'", l)
+		t.FailNow()
+	}
+
+	z := zz[0]
+	if z.Geometry.Type != "MultiPolygon" || len(z.Geometry.Coordinates[0][0]) != 4 {
+		t.Errorf("expect multipolygon with 4 positions got '%v'", z.Geometry)
+	}
+
+	if z.Properties.Start != 1593878400 || z.Properties.End != 1593907260 {
+		t.Errorf("expect '1593878400, 1593907260' got '%d, %d'", z.Properties.Start, z.Properties.End)
+	}
+
+	r := z.Properties.Rules[0]
+	if r.RideAllowed || !r.RideThroughAllowed || !r.StationParking || r.MaximumSpeedKph != 10 {
+		t.Errorf("expect 'false, true, true, 10' got '%t, %t, %t, %d'",
+			r.RideAllowed, r.RideThroughAllowed, r.StationParking, r.MaximumSpeedKph)
+	}
+}
+
+func TestGeofencingZoneRule_AppliesTo(t *testing.T) {
+	ii := []struct {
+		in  GeofencingZoneRule
+		id  string
+		out bool
+	}{
+		{in: GeofencingZoneRule{}, id: "car1", out: true},
+		{in: GeofencingZoneRule{VehicleTypeIDs: []string{"moped1", "car1"}}, id: "car1", out: true},
+		{in: GeofencingZoneRule{VehicleTypeIDs: []string{"moped1"}}, id: "car1", out: false},
+	}
+
+	for _, i := range ii {
+		if got := i.in.AppliesTo(i.id); got != i.out {
+			t.Errorf("expect '%t' got '%t' for '%s'", i.out, got, i.id)
+		}
+	}
+}
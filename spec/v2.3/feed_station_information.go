@@ -0,0 +1,85 @@
+package gbfsspec
+
+type (
+	FeedStationInformation struct {
+		Metadata
+
+		Data StationInformationData `json:"data"`
+	}
+
+	StationInformationData struct {
+		// Array that contains one object per station as defined below.
+		Stations []StationInformation `json:"stations"`
+	}
+
+	StationInformation struct {
+		// Identifier of a station.
+		StationID string `json:"station_id"`
+
+		// Public name of the station.
+		Name string `json:"name"`
+
+		// Short name or other type of identifier.
+		ShortName string `json:"short_name,omitempty"`
+
+		// The latitude of station.
+		Latitude float64 `json:"lat"`
+
+		// The longitude of station.
+		Longitude float64 `json:"lon"`
+
+		// Address (street number and name) where station is located. This
+		Address string `json:"address,omitempty"`
+
+		// Cross street or landmark where the station is located.
+		CrossStreet string `json:"cross_street,omitempty"`
+
+		// Identifier of the region where station is located. See SystemRegion
+		RegionID string `json:"region_id,omitempty"`
+
+		// Postal code where station is located.
+		PostCode string `json:"post_code,omitempty"`
+
+		// Payment methods accepted at this station.
+		RentalMethods []RentalMethod `json:"rental_methods,omitempty"`
+
+		// Number of total docking points installed at this station, both available and unavailable.
+		Capacity int `json:"capacity,omitempty"`
+
+		// Contains rental URIs for Android, iOS, and web in the android, ios, and web fields.
+		RentalURIs RentalURIs `json:"rental_uris,omitempty"`
+
+		// Is this station a location with or without physical infrastructure (docks)?
+		IsVirtualStation Boolean `json:"is_virtual_station,omitempty"`
+
+		// A GeoJSON multipolygon that describes the area of a virtual station.
+		StationArea *MultiPolygon `json:"station_area,omitempty"`
+
+		// An object where each key is a vehicle_type_id and the value is a number representing
+		// the total docking points installed at this station for each vehicle type.
+		VehicleTypeCapacity map[string]int `json:"vehicle_type_capacity,omitempty"`
+
+		// An object where each key is a vehicle_type_id and the value is a number representing
+		// the total number of vehicles of this type that can park within the station_area.
+		VehicleCapacity map[string]int `json:"vehicle_capacity,omitempty"`
+
+		// Are valet services provided at this station?
+		IsValetStation Boolean `json:"is_valet_station,omitempty"`
+
+		// Type of parking station: parking_lot, street_parking, underground_parking, sidewalk_parking or other.
+		ParkingType ParkingType `json:"parking_type,omitempty"`
+
+		// Are parking hoops present at this station?
+		ParkingHoop Boolean `json:"parking_hoop,omitempty"`
+
+		// Contact phone of the station.
+		ContactPhone string `json:"contact_phone,omitempty"`
+
+		// Does the station support charging of electric vehicles?
+		IsChargingStation Boolean `json:"is_charging_station,omitempty"`
+	}
+)
+
+func (_ FeedStationInformation) FeedKey() string {
+	return FeedKeyStationInformation
+}
@@ -0,0 +1,51 @@
+package gbfsspec
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFeedStationInformation_FeedKey(t *testing.T) {
+	var f FeedStationInformation
+
+	if k := f.FeedKey(); k != FeedKeyStationInformation {
+		t.Errorf("expect '%s' got '%s'", FeedKeyStationInformation, k)
+	}
+}
+
+func TestFeedStationInformation_UnmarshalJSON(t *testing.T) {
+	in := `{"last_updated": 1609866247, "ttl": 0, "version": "2.3", "data": {"stations": [{"station_id": "pga",
+		"name": "Parking garage A", "lat": 12.345678, "lon": 45.678901, "is_virtual_station": true,
+		"station_area": {"type": "MultiPolygon", "coordinates": [[[[-122.655775, 45.516445], [-122.655705, 45.516445],
+		[-122.655705, 45.516495], [-122.655775, 45.516445]]]]}, "vehicle_type_capacity": {"abc123": 7, "def456": 9},
+		"parking_type": "underground_parking", "parking_hoop": false, "contact_phone": "+33109874321",
+		"is_charging_station": true, "is_valet_station": false}]}}`
+
+	var f FeedStationInformation
+	if err := json.Unmarshal([]byte(in), &f); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	s := f.Data.Stations[0]
+	if !s.IsVirtualStation {
+		t.Errorf("expect 'true' got '%t'", s.IsVirtualStation)
+	}
+
+	if s.StationArea == nil || len(s.StationArea.Coordinates[0][0]) != 4 {
+		t.Errorf("expect station area with 4 positions got '%v'", s.StationArea)
+	}
+
+	if c := s.VehicleTypeCapacity["def456"]; c != 9 {
+		t.Errorf("expect '9' got '%d'", c)
+	}
+
+	if s.ParkingType != ParkingTypeUndergroundParking {
+		t.Errorf("expect '%s' got '%s'", ParkingTypeUndergroundParking, s.ParkingType)
+	}
+
+	if s.ContactPhone != "+33109874321" || !s.IsChargingStation || s.ParkingHoop || s.IsValetStation {
+		t.Errorf("expect '+33109874321, true, false, false' got '%s, %t, %t, %t'",
+			s.ContactPhone, s.IsChargingStation, s.ParkingHoop, s.IsValetStation)
+	}
+}
@@ -0,0 +1,73 @@
+package gbfsspec
+
+type (
+	FeedStationStatus struct {
+		Metadata
+
+		Data StationStatusData `json:"data"`
+	}
+
+	StationStatusData struct {
+		// Array that contains one object per station in the system
+		Stations []StationStatus `json:"stations"`
+	}
+
+	StationStatus struct {
+		// Identifier of a station see station_information.
+		StationID string `json:"station_id"`
+
+		// Number of bikes available for rental. Number of functional bikes physically at the station.
+		// To know if the bikes are available for rental.
+		NumBikesAvailable int `json:"num_bikes_available"`
+
+		// Number of disabled bikes at the station.
+		NumBikesDisabled int `json:"num_bikes_disabled,omitempty"`
+
+		// Required except for stations that have unlimited docking capacity (e.g. virtual stations).
+		// Number of functional docks physically at the station.
+		NumDocksAvailable int `json:"num_docks_available,omitempty"`
+
+		// Number of empty but disabled dock points at the station.
+		NumDocksDisabled int `json:"num_docks_disabled,omitempty"`
+
+		// Is the station currently on the street?
+		IsInstalled Boolean `json:"is_installed"`
+
+		// Is the station currently renting bikes?
+		IsRenting Boolean `json:"is_renting"`
+
+		// Is the station accepting bike returns?
+		IsReturning Boolean `json:"is_returning"`
+
+		// The last time this station reported its status.
+		LastReported Timestamp `json:"last_reported"`
+
+		// Array of objects displaying the total number of each vehicle type at the station.
+		// Required if the vehicle_types feed is defined.
+		VehicleTypesAvailable []StationVehicleTypeAvailable `json:"vehicle_types_available,omitempty"`
+
+		// Array of objects displaying the total number of available docks by vehicle type at the station.
+		VehicleDocksAvailable []StationVehicleDocksAvailable `json:"vehicle_docks_available,omitempty"`
+	}
+
+	StationVehicleTypeAvailable struct {
+		// The vehicle_type_id of vehicle at the station.
+		VehicleTypeID string `json:"vehicle_type_id"`
+
+		// A number representing the total amount of this vehicle type at the station.
+		Count int `json:"count"`
+	}
+
+	StationVehicleDocksAvailable struct {
+		// An array of strings where each string represents a vehicle_type_id that is able to use
+		// a particular type of dock at the station.
+		VehicleTypeIDs []string `json:"vehicle_type_ids"`
+
+		// A number representing the total number of available docks for the defined vehicle type.
+		Count int `json:"count"`
+	}
+)
+
+func (_ FeedStationStatus) FeedKey() string {
+	return FeedKeyStationStatus
+}
@@ -0,0 +1,11 @@
+package gbfsspec
+
+import "testing"
+
+func TestFeedSystemInformation_FeedKey(t *testing.T) {
+	var f FeedSystemInformation
+
+	if k := f.FeedKey(); k != FeedKeySystemInformation {
+		t.Errorf("expect '%s' got '%s'", FeedKeySystemInformation, k)
+	}
+}
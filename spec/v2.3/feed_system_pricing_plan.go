@@ -0,0 +1,65 @@
+package gbfsspec
+
+type (
+	FeedSystemPricingPlans struct {
+		Metadata
+
+		Data SystemPricingPlansData `json:"data"`
+	}
+
+	SystemPricingPlansData struct {
+		Plans []SystemPricingPlan `json:"plans"`
+	}
+
+	SystemPricingPlan struct {
+		// Identifier for a pricing plan in the system.
+		PlanID string `json:"plan_id"`
+
+		// URL where the customer can learn more about this pricing plan.
+		URL string `json:"url,omitempty"`
+
+		// Name of this pricing plan.
+		Name string `json:"name"`
+
+		// Currency used to pay the fare. (ISO 4217)
+		Currency string `json:"currency"`
+
+		// Currency used to pay the fare.
+		Price Price `json:"price"`
+
+		// Will additional tax be added to the base price?
+		IsTaxable Boolean `json:"is_taxable"`
+
+		// Customer-readable description of the pricing plan. This should include the duration, price,
+		// conditions, etc. that the publisher would like users to see.
+		Description string `json:"description"`
+
+		// Array of segments charged by distance, in kilometers. The segments are applied in addition to the price.
+		PerKmPricing []SystemPricingSegment `json:"per_km_pricing,omitempty"`
+
+		// Array of segments charged by duration, in minutes. The segments are applied in addition to the price.
+		PerMinPricing []SystemPricingSegment `json:"per_min_pricing,omitempty"`
+
+		// Is there currently an increase in price in response to increased demand in this pricing plan?
+		SurgePricing Boolean `json:"surge_pricing,omitempty"`
+	}
+
+	SystemPricingSegment struct {
+		// Number of kilometers or minutes at which the rate will start being charged.
+		Start int `json:"start"`
+
+		// Rate that is charged for each interval after the start. Can be negative to indicate a discount.
+		Rate float64 `json:"rate"`
+
+		// Interval in kilometers or minutes at which the rate is charged, 0 when it is charged only once.
+		Interval int `json:"interval"`
+
+		// Number of kilometers or minutes at which the rate will no longer apply, 0 when it applies until the end
+		// of the trip.
+		End int `json:"end,omitempty"`
+	}
+)
+
+func (_ FeedSystemPricingPlans) FeedKey() string {
+	return FeedKeySystemPricingPlans
+}
@@ -0,0 +1,52 @@
+package gbfsspec
+
+type (
+	FeedVehicleTypes struct {
+		Metadata
+
+		Data VehicleTypesData `json:"data"`
+	}
+
+	VehicleTypesData struct {
+		// Array that contains one object per vehicle type in the system as defined below.
+		VehicleTypes []VehicleType `json:"vehicle_types"`
+	}
+
+	VehicleType struct {
+		// Unique identifier of a vehicle type.
+		VehicleTypeID string `json:"vehicle_type_id"`
+
+		// The vehicle's general form factor.
+		FormFactor FormFactor `json:"form_factor"`
+
+		// The primary propulsion type of the vehicle.
+		PropulsionType PropulsionType `json:"propulsion_type"`
+
+		// If the vehicle has a motor (propulsion_type is not human), this field is required.
+		// The furthest distance in meters that the vehicle can travel without recharging or refueling
+		// when it has the maximum amount of energy potential.
+		MaxRangeMeters float64 `json:"max_range_meters,omitempty"`
+
+		// The public name of this vehicle type.
+		Name string `json:"name,omitempty"`
+
+		// The plan_id of the pricing plan, as described in system_pricing_plans, applying to this vehicle type.
+		PricingPlanID string `json:"pricing_plan_id,omitempty"`
+
+		// The maximum speed in kilometers per hour this vehicle is permitted to reach in accordance with local permit
+		// and regulations.
+		MaxPermittedSpeed int `json:"max_permitted_speed,omitempty"`
+
+		// The rated power of the motor for this vehicle type in watts.
+		RatedPower int `json:"rated_power,omitempty"`
+	}
+)
+
+func (_ FeedVehicleTypes) FeedKey() string {
+	return FeedKeyVehicleTypes
+}
+
+// IsMotorized return true when the vehicle has a motor
+func (v VehicleType) IsMotorized() bool {
+	return v.PropulsionType != PropulsionTypeHuman
+}
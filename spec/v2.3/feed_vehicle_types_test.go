@@ -0,0 +1,59 @@
+package gbfsspec
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFeedVehicleTypes_FeedKey(t *testing.T) {
+	var f FeedVehicleTypes
+
+	if k := f.FeedKey(); k != FeedKeyVehicleTypes {
+		t.Errorf("expect '%s' got '%s'", FeedKeyVehicleTypes, k)
+	}
+}
+
+func TestVehicleType_IsMotorized(t *testing.T) {
+	ii := []struct {
+		in  PropulsionType
+		out bool
+	}{
+		{in: PropulsionTypeHuman, out: false},
+		{in: PropulsionTypeElectricAssist, out: true},
+		{in: PropulsionTypeElectric, out: true},
+		{in: PropulsionTypeCombustion, out: true},
+	}
+
+	for _, i := range ii {
+		if got := (VehicleType{PropulsionType: i.in}).IsMotorized(); got != i.out {
+			t.Errorf("expect '%t' got '%t' for '%s'", i.out, got, i.in)
+		}
+	}
+}
+
+func TestFeedVehicleTypes_UnmarshalJSON(t *testing.T) {
+	in := `{"last_updated": 1609866247, "ttl": 0, "version": "2.3", "data": {"vehicle_types": [
+		{"vehicle_type_id": "abc123", "form_factor": "bicycle", "propulsion_type": "human", "name": "Example Basic Bike"},
+		{"vehicle_type_id": "def456", "form_factor": "scooter", "propulsion_type": "electric",
+			"name": "Example E-scooter V2", "max_range_meters": 12345, "pricing_plan_id": "plan3",
+			"max_permitted_speed": 25, "rated_power": 350}]}}`
+
+	var f FeedVehicleTypes
+	if err := json.Unmarshal([]byte(in), &f); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	v := f.Data.VehicleTypes[1]
+	if v.FormFactor != FormFactorScooter || v.PropulsionType != PropulsionTypeElectric {
+		t.Errorf("expect 'scooter, electric' got '%s, %s'", v.FormFactor, v.PropulsionType)
+	}
+
+	if v.MaxRangeMeters != 12345 {
+		t.Errorf("expect '12345' got '%f'", v.MaxRangeMeters)
+	}
+
+	if v.PricingPlanID != "plan3" || v.MaxPermittedSpeed != 25 || v.RatedPower != 350 {
+		t.Errorf("expect 'plan3, 25, 350' got '%s, %d, %d'", v.PricingPlanID, v.MaxPermittedSpeed, v.RatedPower)
+	}
+}
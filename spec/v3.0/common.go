@@ -0,0 +1,126 @@
+package gbfsspec
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	spec20 "github.com/Eraac/gbfs/spec/v2.0"
+	spec21 "github.com/Eraac/gbfs/spec/v2.1"
+	spec23 "github.com/Eraac/gbfs/spec/v2.3"
+)
+
+// Enum from the spec
+type (
+	AlertType    string
+	RentalMethod string
+)
+
+// Enum from the spec, shared with the others versions
+type (
+	FormFactor     = spec21.FormFactor
+	PropulsionType = spec21.PropulsionType
+	ParkingType    = spec23.ParkingType
+)
+
+type (
+	// Service day in the YYYY-MM-DD format. Example: 2019-09-13 for September 13th, 2019.
+	Date = spec20.Date
+
+	// Timestamp in the RFC3339 format. Example: 2023-07-17T13:34:13+02:00
+	Timestamp struct {
+		time.Time
+	}
+
+	// Array of the translations of a string, one per language
+	LocalizedString []LocalizedText
+
+	LocalizedText struct {
+		// The translated text.
+		Text string `json:"text"`
+
+		// IETF BCP 47 language code.
+		Language string `json:"language"`
+	}
+)
+
+// Custom type for handle many format used by different provider (or the spec)
+type (
+	Price   = spec20.Price
+	Boolean = spec20.Boolean
+)
+
+const (
+	DateFormat = spec20.DateFormat
+)
+
+// ToTime return the timestamp as a time.Time
+func (t Timestamp) ToTime() time.Time {
+	return t.Time
+}
+
+// UnmarshalJSON parse the timestamp in RFC3339,
+// or in POSIX time as in the previous versions of the spec
+func (t *Timestamp) UnmarshalJSON(bs []byte) error {
+	v := string(bs)
+
+	if v == "null" || v == `""` {
+		*t = Timestamp{}
+		return nil
+	}
+
+	if strings.HasPrefix(v, `"`) {
+		tt, err := time.Parse(time.RFC3339, strings.Trim(v, `"`))
+		if err != nil {
+			return err
+		}
+
+		*t = Timestamp{Time: tt}
+		return nil
+	}
+
+	s, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*t = Timestamp{Time: time.Unix(s, 0)}
+
+	return nil
+}
+
+// MarshalJSON encode the timestamp in RFC3339, or null when zero
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.Format(time.RFC3339))
+}
+
+// Get return the text in the language 'lang', or in the same primary language (e.g. "en" for "en-US"),
+// or the first text when there is no translation for the language
+func (s LocalizedString) Get(lang string) string {
+	if len(s) == 0 {
+		return ""
+	}
+
+	for _, t := range s {
+		if strings.EqualFold(t.Language, lang) {
+			return t.Text
+		}
+	}
+
+	primary := func(l string) string {
+		return strings.ToLower(strings.SplitN(l, "-", 2)[0])
+	}
+
+	for _, t := range s {
+		if primary(t.Language) == primary(lang) {
+			return t.Text
+		}
+	}
+
+	return s[0].Text
+}
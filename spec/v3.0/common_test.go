@@ -0,0 +1,85 @@
+package gbfsspec
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimestamp_UnmarshalJSON(t *testing.T) {
+	ii := []struct {
+		in              string
+		out             time.Time
+		shouldReturnErr bool
+	}{
+		{in: `"2023-07-17T13:34:13+02:00"`, out: time.Date(2023, 7, 17, 11, 34, 13, 0, time.UTC)},
+		{in: `1689593653`, out: time.Date(2023, 7, 17, 11, 34, 13, 0, time.UTC)},
+		{in: `null`, out: time.Time{}},
+		{in: `"2023-07-17"`, shouldReturnErr: true},
+		{in: `true`, shouldReturnErr: true},
+	}
+
+	for _, i := range ii {
+		var ts Timestamp
+		err := json.Unmarshal([]byte(i.in), &ts)
+
+		if err == nil && i.shouldReturnErr {
+			t.Errorf("expect error got nil for '%s'", i.in)
+		}
+
+		if err != nil && !i.shouldReturnErr {
+			t.Errorf("expect 'nil' got '%s' for '%s'", err, i.in)
+		}
+
+		if !i.shouldReturnErr && !ts.ToTime().Equal(i.out) {
+			t.Errorf("expect '%s' got '%s'", i.out, ts.ToTime())
+		}
+	}
+}
+
+func TestTimestamp_MarshalJSON(t *testing.T) {
+	ii := []struct {
+		in  Timestamp
+		out string
+	}{
+		{in: Timestamp{Time: time.Date(2023, 7, 17, 11, 34, 13, 0, time.UTC)}, out: `"2023-07-17T11:34:13Z"`},
+		{in: Timestamp{}, out: `null`},
+	}
+
+	for _, i := range ii {
+		bs, err := json.Marshal(i.in)
+		if err != nil {
+			t.Errorf("expect 'nil' got '%s'", err)
+			continue
+		}
+
+		if string(bs) != i.out {
+			t.Errorf("expect '%s' got '%s'", i.out, bs)
+		}
+	}
+}
+
+func TestLocalizedString_Get(t *testing.T) {
+	s := LocalizedString{
+		{Text: "Bike share", Language: "en"},
+		{Text: "Vélos en libre-service", Language: "fr-CA"},
+	}
+
+	ii := []struct {
+		in        LocalizedString
+		lang, out string
+	}{
+		{in: s, lang: "en", out: "Bike share"},
+		{in: s, lang: "fr-CA", out: "Vélos en libre-service"},
+		{in: s, lang: "FR", out: "Vélos en libre-service"},
+		{in: s, lang: "en-US", out: "Bike share"},
+		{in: s, lang: "de", out: "Bike share"},
+		{in: nil, lang: "en", out: ""},
+	}
+
+	for _, i := range ii {
+		if got := i.in.Get(i.lang); got != i.out {
+			t.Errorf("expect '%s' got '%s' for '%s'", i.out, got, i.lang)
+		}
+	}
+}
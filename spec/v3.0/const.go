@@ -0,0 +1,65 @@
+package gbfsspec
+
+const Version = "3.0"
+
+const (
+	FeedKeyAutoDiscovery      = "gbfs"
+	FeedKeyManifest           = "manifest"
+	FeedKeyGBFSVersions       = "gbfs_versions"
+	FeedKeySystemInformation  = "system_information"
+	FeedKeyVehicleTypes       = "vehicle_types"
+	FeedKeyStationInformation = "station_information"
+	FeedKeyStationStatus      = "station_status"
+	FeedKeyVehicleStatus      = "vehicle_status"
+	FeedKeySystemRegions      = "system_regions"
+	FeedKeySystemPricingPlans = "system_pricing_plans"
+	FeedKeySystemAlerts       = "system_alerts"
+	FeedKeyGeofencingZones    = "geofencing_zones"
+)
+
+const (
+	RentalMethodKey           RentalMethod = "key"
+	RentalMethodCreditCard    RentalMethod = "creditcard"
+	RentalMethodPayPass       RentalMethod = "paypass"
+	RentalMethodApplePay      RentalMethod = "applepay"
+	RentalMethodAndroidPay    RentalMethod = "androidpay"
+	RentalMethodTransitCard   RentalMethod = "transitcard"
+	RentalMethodAccountNumber RentalMethod = "accountnumber"
+	RentalMethodPhone         RentalMethod = "phone"
+)
+
+const (
+	FormFactorBicycle         FormFactor = "bicycle"
+	FormFactorCargoBicycle    FormFactor = "cargo_bicycle"
+	FormFactorCar             FormFactor = "car"
+	FormFactorMoped           FormFactor = "moped"
+	FormFactorScooterStanding FormFactor = "scooter_standing"
+	FormFactorScooterSeated   FormFactor = "scooter_seated"
+	FormFactorOther           FormFactor = "other"
+)
+
+const (
+	PropulsionTypeHuman            PropulsionType = "human"
+	PropulsionTypeElectricAssist   PropulsionType = "electric_assist"
+	PropulsionTypeElectric         PropulsionType = "electric"
+	PropulsionTypeCombustion       PropulsionType = "combustion"
+	PropulsionTypeCombustionDiesel PropulsionType = "combustion_diesel"
+	PropulsionTypeHybrid           PropulsionType = "hybrid"
+	PropulsionTypePlugInHybrid     PropulsionType = "plug_in_hybrid"
+	PropulsionTypeHydrogenFuelCell PropulsionType = "hydrogen_fuel_cell"
+)
+
+const (
+	ParkingTypeParkingLot         ParkingType = "parking_lot"
+	ParkingTypeStreetParking      ParkingType = "street_parking"
+	ParkingTypeUndergroundParking ParkingType = "underground_parking"
+	ParkingTypeSidewalkParking    ParkingType = "sidewalk_parking"
+	ParkingTypeOther              ParkingType = "other"
+)
+
+const (
+	AlertTypeSystemClosure  AlertType = "system_closure"
+	AlertTypeStationClosure AlertType = "station_closure"
+	AlertTypeStationMove    AlertType = "station_move"
+	AlertTypeOther          AlertType = "other"
+)
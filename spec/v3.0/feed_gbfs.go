@@ -0,0 +1,39 @@
+package gbfsspec
+
+type (
+	FeedGBFS struct {
+		Metadata
+
+		Data GBFSData `json:"data"`
+	}
+
+	GBFSData struct {
+		// An array of all of the feeds that are published by this auto-discovery file.
+		// The languages are no longer listed here, see SystemInformationData.Languages
+		Feeds []GBFSFeed `json:"feeds"`
+	}
+
+	GBFSFeed struct {
+		// Key identifying the type of feed this is. The key must be the base file name defined in the spec for
+		// the corresponding feed type (system_information for system_information.json file).
+		Name string `json:"name"`
+
+		// URL for the feed. Note that the actual feed endpoints (urls) may not be defined in the file_name.json format.
+		URL string `json:"url"`
+	}
+)
+
+func (_ FeedGBFS) FeedKey() string {
+	return FeedKeyAutoDiscovery
+}
+
+// URL return the URL of the feed 'key', and false when the feed is not listed
+func (d GBFSData) URL(key string) (string, bool) {
+	for _, f := range d.Feeds {
+		if f.Name == key {
+			return f.URL, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,39 @@
+package gbfsspec
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFeedGBFS_FeedKey(t *testing.T) {
+	var f FeedGBFS
+
+	if k := f.FeedKey(); k != FeedKeyAutoDiscovery {
+		t.Errorf("expect '%s' got '%s'", FeedKeyAutoDiscovery, k)
+	}
+}
+
+func TestFeedGBFS_UnmarshalJSON(t *testing.T) {
+	in := `{"last_updated": "2023-07-17T13:34:13+02:00", "ttl": 0, "version": "3.0", "data": {"feeds": [
+		{"name": "system_information", "url": "https://www.example.com/gbfs/1/system_information"},
+		{"name": "station_information", "url": "https://www.example.com/gbfs/1/station_information"}]}}`
+
+	var f FeedGBFS
+	if err := json.Unmarshal([]byte(in), &f); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if f.LastUpdated.Unix() != 1689593653 {
+		t.Errorf("expect '1689593653' got '%d'", f.LastUpdated.Unix())
+	}
+
+	u, ok := f.Data.URL(FeedKeyStationInformation)
+	if !ok || u != "https://www.example.com/gbfs/1/station_information" {
+		t.Errorf("expect station_information URL got '%s'", u)
+	}
+
+	if _, ok := f.Data.URL(FeedKeyVehicleStatus); ok {
+		t.Errorf("expect vehicle_status to not be listed")
+	}
+}
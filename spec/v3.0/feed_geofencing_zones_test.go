@@ -0,0 +1,11 @@
+package gbfsspec
+
+import "testing"
+
+func TestFeedGeofencingZones_FeedKey(t *testing.T) {
+	var f FeedGeofencingZones
+
+	if k := f.FeedKey(); k != FeedKeyGeofencingZones {
+		t.Errorf("expect '%s' got '%s'", FeedKeyGeofencingZones, k)
+	}
+}
@@ -0,0 +1,27 @@
+package gbfsspec
+
+type (
+	FeedManifest struct {
+		Metadata
+
+		Data ManifestData `json:"data"`
+	}
+
+	ManifestData struct {
+		// An array of objects, one per dataset (system) published by the producer.
+		Datasets []ManifestDataset `json:"datasets"`
+	}
+
+	ManifestDataset struct {
+		// The system_id from system_information for the corresponding data set(s).
+		SystemID string `json:"system_id"`
+
+		// Contains one object for each of the available versions of a feed.
+		// The array must be sorted by increasing MAJOR and MINOR version number.
+		Versions []GBFSVersion `json:"versions"`
+	}
+)
+
+func (_ FeedManifest) FeedKey() string {
+	return FeedKeyManifest
+}
@@ -0,0 +1,11 @@
+package gbfsspec
+
+import "testing"
+
+func TestFeedManifest_FeedKey(t *testing.T) {
+	var f FeedManifest
+
+	if k := f.FeedKey(); k != FeedKeyManifest {
+		t.Errorf("expect '%s' got '%s'", FeedKeyManifest, k)
+	}
+}
@@ -0,0 +1,96 @@
+package gbfsspec
+
+type (
+	FeedStationInformation struct {
+		Metadata
+
+		Data StationInformationData `json:"data"`
+	}
+
+	StationInformationData struct {
+		// Array that contains one object per station as defined below.
+		Stations []StationInformation `json:"stations"`
+	}
+
+	StationInformation struct {
+		// Identifier of a station.
+		StationID string `json:"station_id"`
+
+		// The public name of the station for display in maps, digital signage, and other text applications.
+		Name LocalizedString `json:"name"`
+
+		// Short name or other type of identifier.
+		ShortName LocalizedString `json:"short_name,omitempty"`
+
+		// The latitude of station.
+		Latitude float64 `json:"lat"`
+
+		// The longitude of station.
+		Longitude float64 `json:"lon"`
+
+		// Address (street number and name) where station is located.
+		Address string `json:"address,omitempty"`
+
+		// Cross street or landmark where the station is located.
+		CrossStreet string `json:"cross_street,omitempty"`
+
+		// Identifier of the region where station is located. See SystemRegion
+		RegionID string `json:"region_id,omitempty"`
+
+		// Postal code where station is located.
+		PostCode string `json:"post_code,omitempty"`
+
+		// Hours of operation for the station in OSM opening_hours format.
+		StationOpeningHours string `json:"station_opening_hours,omitempty"`
+
+		// Payment methods accepted at this station.
+		RentalMethods []RentalMethod `json:"rental_methods,omitempty"`
+
+		// Is this station a location with or without smart dock technology?
+		IsVirtualStation Boolean `json:"is_virtual_station,omitempty"`
+
+		// A GeoJSON MultiPolygon that describes the area of a virtual station.
+		StationArea *MultiPolygon `json:"station_area,omitempty"`
+
+		// Type of parking station.
+		ParkingType ParkingType `json:"parking_type,omitempty"`
+
+		// Are parking hoops present at this station?
+		ParkingHoop Boolean `json:"parking_hoop,omitempty"`
+
+		// Contact phone of the station.
+		ContactPhone string `json:"contact_phone,omitempty"`
+
+		// Number of total docking points installed at this station, both available and unavailable.
+		Capacity int `json:"capacity,omitempty"`
+
+		// This field's value is an array of objects containing the total number of vehicles, by vehicle type,
+		// that can park within the station_area.
+		VehicleTypesCapacity []StationVehicleTypesCount `json:"vehicle_types_capacity,omitempty"`
+
+		// This field's value is an array of objects containing the total docking points installed at this station
+		// for each vehicle type.
+		VehicleDocksCapacity []StationVehicleTypesCount `json:"vehicle_docks_capacity,omitempty"`
+
+		// Are valet services provided at this station?
+		IsValetStation Boolean `json:"is_valet_station,omitempty"`
+
+		// Does the station support charging of electric vehicles?
+		IsChargingStation Boolean `json:"is_charging_station,omitempty"`
+
+		// Contains rental URIs for Android, iOS, and web in the android, ios, and web fields.
+		RentalURIs RentalURIs `json:"rental_uris,omitempty"`
+	}
+
+	StationVehicleTypesCount struct {
+		// An array of strings where each string represents a vehicle_type_id.
+		VehicleTypeIDs []string `json:"vehicle_type_ids"`
+
+		// The number of vehicles or docks for the vehicle types.
+		Count int `json:"count"`
+	}
+)
+
+func (_ FeedStationInformation) FeedKey() string {
+	return FeedKeyStationInformation
+}
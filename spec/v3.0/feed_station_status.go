@@ -0,0 +1,61 @@
+package gbfsspec
+
+type (
+	FeedStationStatus struct {
+		Metadata
+
+		Data StationStatusData `json:"data"`
+	}
+
+	StationStatusData struct {
+		// Array that contains one object per station in the system
+		Stations []StationStatus `json:"stations"`
+	}
+
+	StationStatus struct {
+		// Identifier of a station see station_information.
+		StationID string `json:"station_id"`
+
+		// Number of functional vehicles physically at the station that may be offered for rental.
+		NumVehiclesAvailable int `json:"num_vehicles_available"`
+
+		// Array of objects displaying the total number of each vehicle type at the station.
+		VehicleTypesAvailable []StationVehicleTypeAvailable `json:"vehicle_types_available,omitempty"`
+
+		// Number of disabled vehicles of any type at the station.
+		NumVehiclesDisabled int `json:"num_vehicles_disabled,omitempty"`
+
+		// Number of functional docks physically at the station that are able to accept vehicles for return.
+		NumDocksAvailable int `json:"num_docks_available,omitempty"`
+
+		// Array of objects displaying the total number of available docks by vehicle type at the station.
+		VehicleDocksAvailable []StationVehicleTypesCount `json:"vehicle_docks_available,omitempty"`
+
+		// Number of empty but disabled docks at the station.
+		NumDocksDisabled int `json:"num_docks_disabled,omitempty"`
+
+		// Is the station currently on the street?
+		IsInstalled Boolean `json:"is_installed"`
+
+		// Is the station currently renting vehicles?
+		IsRenting Boolean `json:"is_renting"`
+
+		// Is the station accepting vehicle returns?
+		IsReturning Boolean `json:"is_returning"`
+
+		// The last time this station reported its status.
+		LastReported Timestamp `json:"last_reported"`
+	}
+
+	StationVehicleTypeAvailable struct {
+		// The vehicle_type_id of each vehicle type at the station.
+		VehicleTypeID string `json:"vehicle_type_id"`
+
+		// A number representing the total number of available vehicles of the corresponding vehicle type.
+		Count int `json:"count"`
+	}
+)
+
+func (_ FeedStationStatus) FeedKey() string {
+	return FeedKeyStationStatus
+}
@@ -0,0 +1,129 @@
+package gbfsspec
+
+import "time"
+
+type (
+	FeedSystemInformation struct {
+		Metadata
+
+		Data SystemInformationData `json:"data"`
+	}
+
+	SystemInformationData struct {
+		// Identifier for this vehicle share system. This should be globally unique (even between different systems).
+		SystemID string `json:"system_id"`
+
+		// List of languages used in translated strings. Each element in the list must be of type Language.
+		Languages []string `json:"languages"`
+
+		// Name of the system to be displayed to customers.
+		Name LocalizedString `json:"name"`
+
+		// Hours and dates of operation for the system in OSM opening_hours format.
+		OpeningHours string `json:"opening_hours"`
+
+		// Abbreviation for a system.
+		ShortName LocalizedString `json:"short_name,omitempty"`
+
+		// Name of the system operator.
+		Operator LocalizedString `json:"operator,omitempty"`
+
+		// The URL of the vehicle share system.
+		URL string `json:"url,omitempty"`
+
+		// URL where a customer can purchase a membership.
+		PurchaseURL string `json:"purchase_url,omitempty"`
+
+		// Date that the system began operations.
+		StartDate Date `json:"start_date,omitempty"`
+
+		// Date after which this data source will no longer be available to consuming applications.
+		TerminationDate Date `json:"termination_date,omitempty"`
+
+		// This optional field should contain a single voice telephone number for the specified system.
+		PhoneNumber string `json:"phone_number,omitempty"`
+
+		// Email address actively monitored by the operator's customer service department.
+		Email string `json:"email,omitempty"`
+
+		// A single contact email address for consumers of this feed to report technical issues.
+		FeedContactEmail string `json:"feed_contact_email"`
+
+		// Fully qualified URL pointing to the location of the manifest.json file for the publisher.
+		ManifestURL string `json:"manifest_url,omitempty"`
+
+		// The time zone where the system is located.
+		Timezone string `json:"timezone"`
+
+		// A fully qualified URL of a page that defines the license terms for the GBFS data for this system.
+		LicenseURL string `json:"license_url,omitempty"`
+
+		// If the feed license requires attribution, name of the organization to which attribution should be provided.
+		AttributionOrganizationName LocalizedString `json:"attribution_organization_name,omitempty"`
+
+		// URL of the organization to which attribution should be provided.
+		AttributionURL string `json:"attribution_url,omitempty"`
+
+		// An object where each field defines one of the items of the brand assets.
+		BrandAssets *SystemInformationBrandAssets `json:"brand_assets,omitempty"`
+
+		// A fully qualified URL pointing to the terms of service (also known as terms of use or terms and conditions)
+		// for the system.
+		TermsURL LocalizedString `json:"terms_url,omitempty"`
+
+		// The date that the terms of service provided at terms_url were last updated.
+		TermsLastUpdated Date `json:"terms_last_updated,omitempty"`
+
+		// A fully qualified URL pointing to the privacy policy for the service.
+		PrivacyURL LocalizedString `json:"privacy_url,omitempty"`
+
+		// The date that the privacy policy provided at privacy_url was last updated.
+		PrivacyLastUpdated Date `json:"privacy_last_updated,omitempty"`
+
+		// Contains rental app information in the android and ios JSON objects.
+		RentalApps SystemInformationRentalApp `json:"rental_apps,omitempty"`
+	}
+
+	SystemInformationBrandAssets struct {
+		// Date that indicates the last time any included brand assets were updated or modified.
+		BrandLastModified Date `json:"brand_last_modified"`
+
+		// A fully qualified URL pointing to the location of a page that defines the license terms of brand icons,
+		// colors, or other trademark information.
+		BrandTermsURL string `json:"brand_terms_url,omitempty"`
+
+		// A fully qualified URL pointing to the location of a graphic file representing the brand for the service.
+		BrandImageURL string `json:"brand_image_url"`
+
+		// A fully qualified URL pointing to the location of a graphic file representing the brand for the service
+		// for use in dark mode.
+		BrandImageURLDark string `json:"brand_image_url_dark,omitempty"`
+
+		// Color used to represent the brand for the service expressed as a 6 digit hexadecimal color code.
+		Color string `json:"color,omitempty"`
+	}
+
+	SystemInformationRentalApp struct {
+		// Contains rental app download and app discovery information for the Android platform.
+		Android SystemInformationRentalAppStore `json:"android,omitempty"`
+
+		// Contains rental information for the iOS platform.
+		IOS SystemInformationRentalAppStore `json:"ios,omitempty"`
+	}
+
+	SystemInformationRentalAppStore struct {
+		// URI where the rental app can be downloaded from.
+		StoreURI string `json:"store_uri,omitempty"`
+
+		// URI that can be used to discover if the rental app is installed on the device.
+		DiscoveryURI string `json:"discovery_uri,omitempty"`
+	}
+)
+
+func (_ FeedSystemInformation) FeedKey() string {
+	return FeedKeySystemInformation
+}
+
+func (s SystemInformationData) GetStartDate() (time.Time, error) {
+	return s.StartDate.ToTime(s.Timezone)
+}
@@ -0,0 +1,40 @@
+package gbfsspec
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFeedSystemInformation_FeedKey(t *testing.T) {
+	var f FeedSystemInformation
+
+	if k := f.FeedKey(); k != FeedKeySystemInformation {
+		t.Errorf("expect '%s' got '%s'", FeedKeySystemInformation, k)
+	}
+}
+
+func TestFeedSystemInformation_UnmarshalJSON(t *testing.T) {
+	in := `{"last_updated": "2023-07-17T13:34:13+02:00", "ttl": 1800, "version": "3.0", "data": {
+		"system_id": "example_cityname", "languages": ["en", "fr"],
+		"name": [{"text": "Example Bike Rental", "language": "en"}, {"text": "Location de vélos", "language": "fr"}],
+		"opening_hours": "Apr 1-Nov 3 00:00-24:00", "feed_contact_email": "datafeed@example.com",
+		"timezone": "America/Chicago", "start_date": "2010-06-10"}}`
+
+	var f FeedSystemInformation
+	if err := json.Unmarshal([]byte(in), &f); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if n := f.Data.Name.Get("fr"); n != "Location de vélos" {
+		t.Errorf("expect 'Location de vélos' got '%s'", n)
+	}
+
+	if l := len(f.Data.Languages); l != 2 {
+		t.Errorf("expect '2' got '%d'", l)
+	}
+
+	if f.Data.OpeningHours != "Apr 1-Nov 3 00:00-24:00" {
+		t.Errorf("expect 'Apr 1-Nov 3 00:00-24:00' got '%s'", f.Data.OpeningHours)
+	}
+}
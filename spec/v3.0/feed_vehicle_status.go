@@ -0,0 +1,65 @@
+package gbfsspec
+
+type (
+	FeedVehicleStatus struct {
+		Metadata
+
+		Data VehicleStatusData `json:"data"`
+	}
+
+	VehicleStatusData struct {
+		// Array that contains one object per vehicle, replace the bikes of free_bike_status.
+		Vehicles []VehicleStatus `json:"vehicles"`
+	}
+
+	VehicleStatus struct {
+		// Identifier of a vehicle, rotated to a random string after each trip to protect privacy.
+		VehicleID string `json:"vehicle_id"`
+
+		// Latitude of the vehicle. Required if station_id is not provided for this vehicle.
+		Latitude float64 `json:"lat,omitempty"`
+
+		// Longitude of the vehicle. Required if station_id is not provided for this vehicle.
+		Longitude float64 `json:"lon,omitempty"`
+
+		// Is the vehicle currently reserved?
+		IsReserved Boolean `json:"is_reserved"`
+
+		// Is the vehicle currently disabled (broken)?
+		IsDisabled Boolean `json:"is_disabled"`
+
+		// Contains rental URIs for Android, iOS, and web in the android, ios, and web fields.
+		RentalURIs RentalURIs `json:"rental_uris,omitempty"`
+
+		// The vehicle_type_id of this vehicle as described in vehicle_types.
+		VehicleTypeID string `json:"vehicle_type_id,omitempty"`
+
+		// The last time this vehicle reported its status to the operator's backend.
+		LastReported Timestamp `json:"last_reported,omitempty"`
+
+		// The furthest distance in meters that the vehicle can travel with the vehicle's current charge or fuel.
+		CurrentRangeMeters float64 `json:"current_range_meters,omitempty"`
+
+		// The current percentage, expressed from 0 to 1, of fuel or battery power remaining in the vehicle.
+		CurrentFuelPercent float64 `json:"current_fuel_percent,omitempty"`
+
+		// Identifier referencing the station_id if the vehicle is currently at a station.
+		StationID string `json:"station_id,omitempty"`
+
+		// The station_id of the station this vehicle must be returned to.
+		HomeStationID string `json:"home_station_id,omitempty"`
+
+		// The plan_id of the pricing plan this vehicle is eligible for as described in system_pricing_plans.
+		PricingPlanID string `json:"pricing_plan_id,omitempty"`
+
+		// List of vehicle equipment provided by the operator.
+		VehicleEquipment []string `json:"vehicle_equipment,omitempty"`
+
+		// The date and time when any rental of the vehicle must be completed.
+		AvailableUntil Timestamp `json:"available_until,omitempty"`
+	}
+)
+
+func (_ FeedVehicleStatus) FeedKey() string {
+	return FeedKeyVehicleStatus
+}
@@ -0,0 +1,37 @@
+package gbfsspec
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestFeedVehicleStatus_FeedKey(t *testing.T) {
+	var f FeedVehicleStatus
+
+	if k := f.FeedKey(); k != FeedKeyVehicleStatus {
+		t.Errorf("expect '%s' got '%s'", FeedKeyVehicleStatus, k)
+	}
+}
+
+func TestFeedVehicleStatus_UnmarshalJSON(t *testing.T) {
+	in := `{"last_updated": "2023-07-17T13:34:13+02:00", "ttl": 0, "version": "3.0", "data": {"vehicles": [
+		{"vehicle_id": "973a5c94-c288-4a2b-afa6-de8aeb6ae2e5", "last_reported": "2023-07-17T13:34:13+02:00",
+		"lat": 12.345678, "lon": 56.789012, "is_reserved": false, "is_disabled": false, "vehicle_type_id": "abc123",
+		"current_range_meters": 400000, "current_fuel_percent": 0.7, "available_until": "2021-05-17T15:00:00Z"}]}}`
+
+	var f FeedVehicleStatus
+	if err := json.Unmarshal([]byte(in), &f); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	v := f.Data.Vehicles[0]
+	if v.CurrentFuelPercent != 0.7 {
+		t.Errorf("expect '0.7' got '%f'", v.CurrentFuelPercent)
+	}
+
+	if want := time.Date(2021, 5, 17, 15, 0, 0, 0, time.UTC); !v.AvailableUntil.Equal(want) {
+		t.Errorf("expect '%s' got '%s'", want, v.AvailableUntil)
+	}
+}
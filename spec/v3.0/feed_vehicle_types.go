@@ -0,0 +1,112 @@
+package gbfsspec
+
+type (
+	FeedVehicleTypes struct {
+		Metadata
+
+		Data VehicleTypesData `json:"data"`
+	}
+
+	VehicleTypesData struct {
+		// Array that contains one object per vehicle type in the system as defined below.
+		VehicleTypes []VehicleType `json:"vehicle_types"`
+	}
+
+	VehicleType struct {
+		// Unique identifier of a vehicle type.
+		VehicleTypeID string `json:"vehicle_type_id"`
+
+		// The vehicle's general form factor.
+		FormFactor FormFactor `json:"form_factor"`
+
+		// The number of riders (driver included) the vehicle can legally accommodate.
+		RiderCapacity int `json:"rider_capacity,omitempty"`
+
+		// Cargo volume available in the vehicle, expressed in liters.
+		CargoVolumeCapacity int `json:"cargo_volume_capacity,omitempty"`
+
+		// The capacity of the vehicle cargo space (excluding passengers), expressed in kilograms.
+		CargoLoadCapacity int `json:"cargo_load_capacity,omitempty"`
+
+		// The primary propulsion type of the vehicle.
+		PropulsionType PropulsionType `json:"propulsion_type"`
+
+		// Vehicle air quality certificate.
+		EcoLabels []VehicleTypeEcoLabel `json:"eco_labels,omitempty"`
+
+		// If the vehicle has a motor, the furthest distance in meters that the vehicle can travel without recharging
+		// or refueling when it has the maximum amount of energy potential.
+		MaxRangeMeters float64 `json:"max_range_meters,omitempty"`
+
+		// The public name of this vehicle type.
+		Name LocalizedString `json:"name,omitempty"`
+
+		// Description of accessories available in the vehicle.
+		VehicleAccessories []string `json:"vehicle_accessories,omitempty"`
+
+		// Maximum quantity of CO2, in grams, emitted per kilometer.
+		GCO2Km int `json:"g_CO2_km,omitempty"`
+
+		// URL to an image that would assist the user in identifying the vehicle.
+		VehicleImage string `json:"vehicle_image,omitempty"`
+
+		// The name of the vehicle manufacturer.
+		Make LocalizedString `json:"make,omitempty"`
+
+		// The name of the vehicle model.
+		Model LocalizedString `json:"model,omitempty"`
+
+		// The color of the vehicle.
+		Color string `json:"color,omitempty"`
+
+		// Customer-readable description of the vehicle type outlining special features or how-tos.
+		Description LocalizedString `json:"description,omitempty"`
+
+		// Number of wheels this vehicle type has.
+		WheelCount int `json:"wheel_count,omitempty"`
+
+		// The maximum speed in kilometers per hour this vehicle is permitted to reach.
+		MaxPermittedSpeed int `json:"max_permitted_speed,omitempty"`
+
+		// The rated power of the motor for this vehicle type in watts.
+		RatedPower int `json:"rated_power,omitempty"`
+
+		// Maximum time in minutes that a vehicle can be reserved before a rental begins.
+		DefaultReserveTime int `json:"default_reserve_time,omitempty"`
+
+		// The conditions for returning the vehicle at the end of the rental.
+		ReturnConstraint string `json:"return_constraint,omitempty"`
+
+		// An object where each key defines one of the items listed below.
+		VehicleAssets *VehicleTypeAssets `json:"vehicle_assets,omitempty"`
+
+		// A plan_id as defined in system_pricing_plans.
+		DefaultPricingPlanID string `json:"default_pricing_plan_id,omitempty"`
+
+		// Array of all pricing plan IDs as defined in system_pricing_plans applied to this vehicle type.
+		PricingPlanIDs []string `json:"pricing_plan_ids,omitempty"`
+	}
+
+	VehicleTypeEcoLabel struct {
+		// Country code following the ISO 3166-1 alpha-2 notation.
+		CountryCode string `json:"country_code"`
+
+		// Name of the eco label.
+		EcoSticker string `json:"eco_sticker"`
+	}
+
+	VehicleTypeAssets struct {
+		// A fully qualified URL pointing to the location of a graphic icon file.
+		IconURL string `json:"icon_url"`
+
+		// A fully qualified URL pointing to the location of a graphic icon file to be used in dark mode.
+		IconURLDark string `json:"icon_url_dark,omitempty"`
+
+		// Date that indicates the last time any included vehicle icon images were modified or updated.
+		IconLastModified Date `json:"icon_last_modified"`
+	}
+)
+
+func (_ FeedVehicleTypes) FeedKey() string {
+	return FeedKeyVehicleTypes
+}
@@ -0,0 +1,11 @@
+package gbfsspec
+
+import "testing"
+
+func TestFeedVehicleTypes_FeedKey(t *testing.T) {
+	var f FeedVehicleTypes
+
+	if k := f.FeedKey(); k != FeedKeyVehicleTypes {
+		t.Errorf("expect '%s' got '%s'", FeedKeyVehicleTypes, k)
+	}
+}
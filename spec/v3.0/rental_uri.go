@@ -0,0 +1,6 @@
+package gbfsspec
+
+import spec20 "github.com/Eraac/gbfs/spec/v2.0"
+
+// Rental URIs for Android, iOS, and web
+type RentalURIs = spec20.RentalURIs
@@ -0,0 +1,164 @@
+package gbfs
+
+import (
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+// Flags of a station
+const (
+	StationFlagInstalled StationFlag = "is_installed"
+	StationFlagRenting   StationFlag = "is_renting"
+	StationFlagReturning StationFlag = "is_returning"
+)
+
+// Counts of a station
+const (
+	StationCountBikesAvailable StationCount = "num_bikes_available"
+	StationCountBikesDisabled  StationCount = "num_bikes_disabled"
+	StationCountDocksAvailable StationCount = "num_docks_available"
+	StationCountDocksDisabled  StationCount = "num_docks_disabled"
+)
+
+type (
+	// StationFlag is the name of a boolean of gbfsspec.StationStatus
+	StationFlag string
+
+	// StationCount is the name of a count of gbfsspec.StationStatus
+	StationCount string
+
+	// StationEvent is a change of a station between two snapshots of station_status,
+	// one of StationAdded, StationRemoved, StationFlagChanged, StationCountChanged or StationStale
+	StationEvent interface {
+		Station() string
+	}
+
+	// StationAdded the station is in the new snapshot only
+	StationAdded struct {
+		Status gbfsspec.StationStatus
+	}
+
+	// StationRemoved the station is in the old snapshot only
+	StationRemoved struct {
+		Status gbfsspec.StationStatus
+	}
+
+	// StationFlagChanged a boolean of the station flipped
+	StationFlagChanged struct {
+		StationID string
+		Flag      StationFlag
+		Old, New  bool
+	}
+
+	// StationCountChanged a count of the station changed
+	StationCountChanged struct {
+		StationID string
+		Count     StationCount
+		Old, New  int
+	}
+
+	// StationStale the station didn't report its status for too long (relative to the last_updated of the feed)
+	StationStale struct {
+		StationID    string
+		LastReported gbfsspec.Timestamp
+		Age          time.Duration
+	}
+)
+
+// Station return the identifier of the station
+func (e StationAdded) Station() string { return e.Status.StationID }
+
+// Station return the identifier of the station
+func (e StationRemoved) Station() string { return e.Status.StationID }
+
+// Station return the identifier of the station
+func (e StationFlagChanged) Station() string { return e.StationID }
+
+// Station return the identifier of the station
+func (e StationCountChanged) Station() string { return e.StationID }
+
+// Station return the identifier of the station
+func (e StationStale) Station() string { return e.StationID }
+
+// DiffStationStatus compare two snapshots of station_status, keyed by station_id, and return the events in the order
+// of the stations of 'next', followed by the removed stations.
+// A station is reported stale once, when its last_reported become older than 'staleAfter' (0 to disable).
+func DiffStationStatus(prev, next gbfsspec.FeedStationStatus, staleAfter time.Duration) []StationEvent {
+	var ee []StationEvent
+
+	old := make(map[string]gbfsspec.StationStatus, len(prev.Data.Stations))
+	for _, s := range prev.Data.Stations {
+		old[s.StationID] = s
+	}
+
+	seen := make(map[string]bool, len(next.Data.Stations))
+
+	for _, n := range next.Data.Stations {
+		seen[n.StationID] = true
+
+		o, ok := old[n.StationID]
+		if !ok {
+			ee = append(ee, StationAdded{Status: n})
+		} else {
+			ee = append(ee, diffStation(o, n)...)
+		}
+
+		if staleAfter <= 0 {
+			continue
+		}
+
+		age := next.LastUpdated.ToTime().Sub(n.LastReported.ToTime())
+		wasStale := ok && prev.LastUpdated.ToTime().Sub(o.LastReported.ToTime()) > staleAfter
+
+		if age > staleAfter && !wasStale {
+			ee = append(ee, StationStale{StationID: n.StationID, LastReported: n.LastReported, Age: age})
+		}
+	}
+
+	for _, o := range prev.Data.Stations {
+		if !seen[o.StationID] {
+			ee = append(ee, StationRemoved{Status: o})
+		}
+	}
+
+	return ee
+}
+
+// diffStation return the flags and counts changed
+func diffStation(o, n gbfsspec.StationStatus) []StationEvent {
+	var ee []StationEvent
+
+	flags := []struct {
+		flag     StationFlag
+		old, new gbfsspec.Boolean
+	}{
+		{flag: StationFlagInstalled, old: o.IsInstalled, new: n.IsInstalled},
+		{flag: StationFlagRenting, old: o.IsRenting, new: n.IsRenting},
+		{flag: StationFlagReturning, old: o.IsReturning, new: n.IsReturning},
+	}
+
+	for _, f := range flags {
+		if f.old != f.new {
+			ee = append(ee, StationFlagChanged{StationID: n.StationID, Flag: f.flag, Old: bool(f.old), New: bool(f.new)})
+		}
+	}
+
+	counts := []struct {
+		count    StationCount
+		old, new int
+	}{
+		{count: StationCountBikesAvailable, old: o.NumBikesAvailable, new: n.NumBikesAvailable},
+		{count: StationCountBikesDisabled, old: o.NumBikesDisabled, new: n.NumBikesDisabled},
+		{count: StationCountDocksAvailable, old: o.NumDocksAvailable, new: n.NumDocksAvailable},
+		{count: StationCountDocksDisabled, old: o.NumDocksDisabled, new: n.NumDocksDisabled},
+	}
+
+	for _, c := range counts {
+		if c.old != c.new {
+			ee = append(ee, StationCountChanged{StationID: n.StationID, Count: c.count, Old: c.old, New: c.new})
+		}
+	}
+
+	return ee
+}
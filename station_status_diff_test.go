@@ -0,0 +1,62 @@
+package gbfs
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+func TestDiffStationStatus(t *testing.T) {
+	prev := gbfsspec.FeedStationStatus{
+		Metadata: gbfsspec.Metadata{LastUpdated: 1000},
+		Data: gbfsspec.StationStatusData{Stations: []gbfsspec.StationStatus{
+			{StationID: "1", NumBikesAvailable: 3, NumDocksAvailable: 7, IsRenting: true, IsReturning: true, LastReported: 990},
+			{StationID: "2", NumBikesAvailable: 1, LastReported: 990},
+			{StationID: "3", LastReported: 100},
+		}},
+	}
+
+	next := gbfsspec.FeedStationStatus{
+		Metadata: gbfsspec.Metadata{LastUpdated: 2000},
+		Data: gbfsspec.StationStatusData{Stations: []gbfsspec.StationStatus{
+			{StationID: "1", NumBikesAvailable: 0, NumDocksAvailable: 10, IsRenting: false, IsReturning: true, LastReported: 1990},
+			{StationID: "3", LastReported: 100},
+			{StationID: "4", LastReported: 1000},
+		}},
+	}
+
+	want := []StationEvent{
+		StationFlagChanged{StationID: "1", Flag: StationFlagRenting, Old: true, New: false},
+		StationCountChanged{StationID: "1", Count: StationCountBikesAvailable, Old: 3, New: 0},
+		StationCountChanged{StationID: "1", Count: StationCountDocksAvailable, Old: 7, New: 10},
+		StationAdded{Status: next.Data.Stations[2]},
+		StationStale{StationID: "4", LastReported: 1000, Age: 1000 * time.Second},
+		StationRemoved{Status: prev.Data.Stations[1]},
+	}
+
+	got := DiffStationStatus(prev, next, 10*time.Minute)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expect '%v' got '%v'", want, got)
+	}
+
+	for i, e := range got {
+		if e.Station() != want[i].Station() {
+			t.Errorf("expect '%s' got '%s'", want[i].Station(), e.Station())
+		}
+	}
+}
+
+func TestDiffStationStatus_noChange(t *testing.T) {
+	f := gbfsspec.FeedStationStatus{
+		Data: gbfsspec.StationStatusData{Stations: []gbfsspec.StationStatus{
+			{StationID: "1", NumBikesAvailable: 3, IsRenting: true},
+		}},
+	}
+
+	if got := DiffStationStatus(f, f, 0); len(got) != 0 {
+		t.Errorf("expect '0' got '%d'", len(got))
+	}
+}
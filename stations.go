@@ -0,0 +1,169 @@
+package gbfs
+
+import (
+	"sync"
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+type (
+	// Station is a station of station_information joined with its status and its region
+	Station struct {
+		ID            string
+		Name          string
+		ShortName     string
+		Latitude      float64
+		Longitude     float64
+		Address       string
+		Capacity      int
+		RentalMethods []gbfsspec.RentalMethod
+
+		RegionID string
+		// Empty when the region is not in system_regions
+		RegionName string
+
+		// False when the station is not in station_status, the fields below are then zero
+		HasStatus         bool
+		NumBikesAvailable int
+		NumBikesDisabled  int
+		NumDocksAvailable int
+		NumDocksDisabled  int
+		IsInstalled       bool
+		IsRenting         bool
+		IsReturning       bool
+		LastReported      time.Time
+	}
+
+	// Stations index the stations by ID, safe for concurrent use
+	// The static information are kept when the status is updated
+	Stations struct {
+		mu sync.RWMutex
+
+		// IDs in the order of station_information
+		ids      []string
+		stations map[string]Station
+	}
+)
+
+// NewStations return the stations of 'info' joined with 'status' and 'regions', who can be nil
+func NewStations(info *gbfsspec.FeedStationInformation, status *gbfsspec.FeedStationStatus, regions *gbfsspec.FeedSystemRegions) *Stations {
+	names := make(map[string]string)
+	if regions != nil {
+		for _, r := range regions.Data.Regions {
+			names[r.RegionID] = r.Name
+		}
+	}
+
+	ss := &Stations{stations: make(map[string]Station)}
+
+	if info != nil {
+		ss.ids = make([]string, 0, len(info.Data.Stations))
+
+		for _, i := range info.Data.Stations {
+			if _, ok := ss.stations[i.StationID]; !ok {
+				ss.ids = append(ss.ids, i.StationID)
+			}
+
+			ss.stations[i.StationID] = Station{
+				ID:            i.StationID,
+				Name:          i.Name,
+				ShortName:     i.ShortName,
+				Latitude:      i.Latitude,
+				Longitude:     i.Longitude,
+				Address:       i.Address,
+				Capacity:      i.Capacity,
+				RentalMethods: i.RentalMethods,
+				RegionID:      i.RegionID,
+				RegionName:    names[i.RegionID],
+			}
+		}
+	}
+
+	if status != nil {
+		ss.UpdateStatus(status)
+	}
+
+	return ss
+}
+
+// Stations return the stations of the system (see NewStations)
+func (s *System) Stations() *Stations {
+	return NewStations(s.StationInformation, s.StationStatus, s.SystemRegions)
+}
+
+// Get return the station with the ID, and false when it doesn't exist
+func (ss *Stations) Get(id string) (Station, bool) {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	s, ok := ss.stations[id]
+
+	return s, ok
+}
+
+// Len return the number of stations
+func (ss *Stations) Len() int {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	return len(ss.ids)
+}
+
+// All return the stations in the order of station_information
+func (ss *Stations) All() []Station {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	all := make([]Station, 0, len(ss.ids))
+	for _, id := range ss.ids {
+		all = append(all, ss.stations[id])
+	}
+
+	return all
+}
+
+// Range call 'fn' for each station in the order of station_information, until it return false
+// The stations can't be updated while iterating
+func (ss *Stations) Range(fn func(s Station) bool) {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	for _, id := range ss.ids {
+		if !fn(ss.stations[id]) {
+			return
+		}
+	}
+}
+
+// UpdateStatus replace the status of the stations, the stations absent from 'status' lose their status
+// The stations unknown by station_information are ignored
+func (ss *Stations) UpdateStatus(status *gbfsspec.FeedStationStatus) {
+	byID := make(map[string]gbfsspec.StationStatus, len(status.Data.Stations))
+	for _, st := range status.Data.Stations {
+		byID[st.StationID] = st
+	}
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	for id, s := range ss.stations {
+		st, ok := byID[id]
+
+		s.HasStatus = ok
+		s.NumBikesAvailable = st.NumBikesAvailable
+		s.NumBikesDisabled = st.NumBikesDisabled
+		s.NumDocksAvailable = st.NumDocksAvailable
+		s.NumDocksDisabled = st.NumDocksDisabled
+		s.IsInstalled = bool(st.IsInstalled)
+		s.IsRenting = bool(st.IsRenting)
+		s.IsReturning = bool(st.IsReturning)
+		s.LastReported = time.Time{}
+
+		if ok {
+			s.LastReported = st.LastReported.ToTime()
+		}
+
+		ss.stations[id] = s
+	}
+}
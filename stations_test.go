@@ -0,0 +1,100 @@
+package gbfs
+
+import (
+	"testing"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+func TestNewStations(t *testing.T) {
+	info := &gbfsspec.FeedStationInformation{Data: gbfsspec.StationInformationData{Stations: []gbfsspec.StationInformation{
+		{StationID: "2", Name: "Mission St", RegionID: "r1", Capacity: 10},
+		{StationID: "1", Name: "Market St", RegionID: "r9"},
+	}}}
+
+	status := &gbfsspec.FeedStationStatus{Data: gbfsspec.StationStatusData{Stations: []gbfsspec.StationStatus{
+		{StationID: "2", NumBikesAvailable: 4, NumDocksAvailable: 6, IsRenting: true, LastReported: 1589230640},
+		{StationID: "3", NumBikesAvailable: 1},
+	}}}
+
+	regions := &gbfsspec.FeedSystemRegions{Data: gbfsspec.SystemRegionsData{Regions: []gbfsspec.SystemRegion{
+		{RegionID: "r1", Name: "San Francisco"},
+	}}}
+
+	ss := NewStations(info, status, regions)
+
+	if l := ss.Len(); l != 2 {
+		t.Errorf("expect '2' got '%d'", l)
+	}
+
+	s, ok := ss.Get("2")
+	if !ok {
+		t.Errorf("expect 'true' got 'false'")
+		t.FailNow()
+	}
+
+	if s.Name != "Mission St" || s.RegionName != "San Francisco" || s.Capacity != 10 {
+		t.Errorf("expect 'Mission St, San Francisco, 10' got '%s, %s, %d'", s.Name, s.RegionName, s.Capacity)
+	}
+
+	if !s.HasStatus || s.NumBikesAvailable != 4 || !s.IsRenting || s.LastReported.Unix() != 1589230640 {
+		t.Errorf("expect status of station '2' got '%+v'", s)
+	}
+
+	if s, _ := ss.Get("1"); s.HasStatus || s.RegionName != "" {
+		t.Errorf("expect no status and no region name got '%+v'", s)
+	}
+
+	if _, ok := ss.Get("3"); ok {
+		t.Errorf("expect 'false' got 'true'")
+	}
+
+	ids := ""
+	ss.Range(func(s Station) bool {
+		ids += s.ID
+		return true
+	})
+
+	if ids != "21" {
+		t.Errorf("expect '21' got '%s'", ids)
+	}
+
+	if all := ss.All(); len(all) != 2 || all[1].ID != "1" {
+		t.Errorf("expect stations '2, 1' got '%v'", all)
+	}
+}
+
+func TestStations_UpdateStatus(t *testing.T) {
+	info := &gbfsspec.FeedStationInformation{Data: gbfsspec.StationInformationData{Stations: []gbfsspec.StationInformation{
+		{StationID: "1", Name: "Market St"},
+		{StationID: "2", Name: "Mission St"},
+	}}}
+
+	ss := NewStations(info, &gbfsspec.FeedStationStatus{Data: gbfsspec.StationStatusData{Stations: []gbfsspec.StationStatus{
+		{StationID: "1", NumBikesAvailable: 4},
+		{StationID: "2", NumBikesAvailable: 2},
+	}}}, nil)
+
+	ss.UpdateStatus(&gbfsspec.FeedStationStatus{Data: gbfsspec.StationStatusData{Stations: []gbfsspec.StationStatus{
+		{StationID: "1", NumBikesAvailable: 7},
+	}}})
+
+	s1, _ := ss.Get("1")
+	if s1.NumBikesAvailable != 7 || s1.Name != "Market St" {
+		t.Errorf("expect '7, Market St' got '%d, %s'", s1.NumBikesAvailable, s1.Name)
+	}
+
+	if s2, _ := ss.Get("2"); s2.HasStatus || s2.NumBikesAvailable != 0 {
+		t.Errorf("expect station '2' without status got '%+v'", s2)
+	}
+
+	ids := 0
+	ss.Range(func(s Station) bool {
+		ids++
+		return false
+	})
+
+	if ids != 1 {
+		t.Errorf("expect '1' got '%d'", ids)
+	}
+}
@@ -0,0 +1,207 @@
+package gbfs
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+// jsonField is a field of a structure, as seen by encoding/json
+type jsonField struct {
+	typ reflect.Type
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// strictWalker compare a JSON document with a structure, and collect the warnings
+type strictWalker struct {
+	// required fields by path of the object, see gbfsspec.RequiredFields
+	required map[string][]string
+
+	ww []gbfsspec.DecodeWarning
+}
+
+// strictWarnings return the unknown fields of the JSON document 'doc' for the type 't',
+// and the required fields absent when 'key' is the key of a feed (see gbfsspec.RequiredFields)
+func strictWarnings(doc interface{}, t reflect.Type, key string) []gbfsspec.DecodeWarning {
+	w := strictWalker{required: gbfsspec.RequiredFields[key]}
+
+	if key != "" {
+		w.missing("", doc, gbfsspec.RequiredRootFields)
+	}
+
+	w.walk("", "", doc, t)
+
+	return w.ww
+}
+
+// walk compare the JSON value 'v' at 'path' with the type 't', 'pattern' is the path without the indexes
+// The types with a custom decoding are not walked
+func (w *strictWalker) walk(path, pattern string, v interface{}, t reflect.Type) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		o, ok := v.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		fields := jsonFields(t)
+
+		for _, k := range sortedKeys(o) {
+			f, ok := lookupField(fields, k)
+			if !ok {
+				w.ww = append(w.ww, gbfsspec.DecodeWarning{
+					Path:    joinPath(path, k),
+					Kind:    gbfsspec.DecodeWarningUnknownField,
+					Message: fmt.Sprintf("unknown field '%s'", k),
+				})
+				continue
+			}
+
+			w.walk(joinPath(path, k), joinPath(pattern, k), o[k], f.typ)
+		}
+
+		if pattern != "" {
+			w.missing(path, o, w.required[pattern])
+		}
+	case reflect.Slice, reflect.Array:
+		a, ok := v.([]interface{})
+		if !ok {
+			return
+		}
+
+		for i, e := range a {
+			w.walk(path+"["+strconv.Itoa(i)+"]", pattern+"[]", e, t.Elem())
+		}
+	case reflect.Map:
+		o, ok := v.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		for _, k := range sortedKeys(o) {
+			w.walk(joinPath(path, k), joinPath(pattern, "*"), o[k], t.Elem())
+		}
+	}
+}
+
+// missing report the fields of 'names' absent from the JSON object 'v' at 'path'
+func (w *strictWalker) missing(path string, v interface{}, names []string) {
+	o, ok := v.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	for _, n := range names {
+		if !hasKey(o, n) {
+			w.ww = append(w.ww, gbfsspec.DecodeWarning{
+				Path:    joinPath(path, n),
+				Kind:    gbfsspec.DecodeWarningMissingField,
+				Message: fmt.Sprintf("required field '%s' is absent", n),
+			})
+		}
+	}
+}
+
+// jsonFields return the fields of the structure by JSON name, with the fields of the embedded structures
+func jsonFields(t reflect.Type) map[string]jsonField {
+	fields := make(map[string]jsonField)
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := tag
+		if i := strings.Index(tag, ","); i >= 0 {
+			name = tag[:i]
+		}
+
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			for n, ef := range jsonFields(f.Type) {
+				fields[n] = ef
+			}
+			continue
+		}
+
+		if f.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		fields[name] = jsonField{typ: f.Type}
+	}
+
+	return fields
+}
+
+// lookupField return the field of the JSON key, matched case-insensitively as encoding/json do
+func lookupField(fields map[string]jsonField, key string) (jsonField, bool) {
+	if f, ok := fields[key]; ok {
+		return f, true
+	}
+
+	for n, f := range fields {
+		if strings.EqualFold(n, key) {
+			return f, true
+		}
+	}
+
+	return jsonField{}, false
+}
+
+// hasKey return true when the JSON object contains the field, matched case-insensitively as encoding/json do
+func hasKey(o map[string]interface{}, name string) bool {
+	if _, ok := o[name]; ok {
+		return true
+	}
+
+	for k := range o {
+		if strings.EqualFold(k, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortedKeys return the keys of the map in alphabetical order
+func sortedKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+
+	ss := make([]string, 0, len(keys))
+	for _, k := range keys {
+		ss = append(ss, k.String())
+	}
+
+	sort.Strings(ss)
+
+	return ss
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
@@ -0,0 +1,127 @@
+package gbfs
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+func TestDecodeFeed_strict(t *testing.T) {
+	raw := []byte(`{"last_updated": 1589230640, "ttl": 0, "Version": "2.0", "data": {"stations": [
+		{"station_id": "1", "num_bikes_available": 2, "is_installed": true, "is_renting": true, "is_returning": true, "last_reported": 1589230640, "x_color": "red"},
+		{"station_id": "2", "num_bikes_available": 2, "is_installed": true, "is_renting": true, "last_reported": 1589230640}
+	]}}`)
+
+	var ss gbfsspec.FeedStationStatus
+	if err := decodeFeed(raw, &ss, true, false); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if l := len(ss.Data.Stations); l != 2 {
+		t.Errorf("expect '2' got '%d'", l)
+	}
+
+	ii := []gbfsspec.DecodeWarning{
+		{Path: "data.stations[0].x_color", Kind: gbfsspec.DecodeWarningUnknownField},
+		{Path: "data.stations[0].num_docks_available", Kind: gbfsspec.DecodeWarningMissingField},
+		{Path: "data.stations[1].num_docks_available", Kind: gbfsspec.DecodeWarningMissingField},
+		{Path: "data.stations[1].is_returning", Kind: gbfsspec.DecodeWarningMissingField},
+	}
+
+	ww := ss.DecodeWarnings()
+	if len(ww) != len(ii) {
+		t.Errorf("expect '%d' got '%d' (%v)", len(ii), len(ww), ww)
+		t.FailNow()
+	}
+
+	for n, i := range ii {
+		if ww[n].Path != i.Path || ww[n].Kind != i.Kind {
+			t.Errorf("expect '%s (%s)' got '%s (%s)'", i.Path, i.Kind, ww[n].Path, ww[n].Kind)
+		}
+	}
+}
+
+func TestDecodeFeed_strictOptional(t *testing.T) {
+	// the end of an alert is optional, its start is required
+	raw := []byte(`{"last_updated": 1589230640, "ttl": 0, "data": {"alerts": [
+		{"alert_id": "1", "type": "OTHER", "summary": "Closed", "times": [{"start": 1589230640}, {"end": 1589230640}]}
+	]}}`)
+
+	var a gbfsspec.FeedSystemAlerts
+	if err := decodeFeed(raw, &a, true, false); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	ww := a.DecodeWarnings()
+	if len(ww) != 1 {
+		t.Errorf("expect '1' got '%d' (%v)", len(ww), ww)
+		t.FailNow()
+	}
+
+	if p := "data.alerts[0].times[1].start"; ww[0].Path != p || ww[0].Kind != gbfsspec.DecodeWarningMissingField {
+		t.Errorf("expect '%s (%s)' got '%s (%s)'", p, gbfsspec.DecodeWarningMissingField, ww[0].Path, ww[0].Kind)
+	}
+}
+
+func TestDecodeFeed_strictCustomDecoding(t *testing.T) {
+	// the languages of gbfs.json are decoded by GBFSData.UnmarshalJSON
+	raw := []byte(`{"last_updated": 1589230640, "ttl": 0, "version": "2.0", "data": {"en": {"feeds": []}}}`)
+
+	var g gbfsspec.FeedGBFS
+	if err := decodeFeed(raw, &g, true, false); err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+	}
+
+	if ww := g.DecodeWarnings(); len(ww) != 0 {
+		t.Errorf("expect '0' got '%v'", ww)
+	}
+}
+
+func TestHTTPClient_StrictDecoding(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"last_updated": %d, "ttl": 300, "data": {"system_id": "BA", "language": "en", "name": "Bay Wheels"}}`, time.Now().Unix())
+	}))
+	defer s.Close()
+
+	ii := []struct {
+		opts     []HTTPOption
+		caching  bool
+		warnings int
+	}{
+		{opts: []HTTPOption{HTTPOptionBaseURL(s.URL)}, warnings: 0},
+		{opts: []HTTPOption{HTTPOptionBaseURL(s.URL), HTTPOptionStrictDecoding()}, warnings: 1},
+		{opts: []HTTPOption{HTTPOptionBaseURL(s.URL), HTTPOptionStrictDecoding()}, caching: true, warnings: 1},
+	}
+
+	for _, i := range ii {
+		c, err := NewHTTPClient(i.opts...)
+		if err != nil {
+			t.Errorf("expect 'nil' got '%s'", err)
+			t.FailNow()
+		}
+
+		if i.caching {
+			c = NewCachingClient(c)
+		}
+
+		// fetched twice, to be served by the cache the second time
+		for n := 0; n < 2; n++ {
+			var si gbfsspec.FeedSystemInformation
+			if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+				t.Errorf("expect 'nil' got '%s'", err)
+				t.FailNow()
+			}
+
+			// the timezone field is absent
+			if ww := si.DecodeWarnings(); len(ww) != i.warnings {
+				t.Errorf("expect '%d' got '%d' (%v)", i.warnings, len(ww), ww)
+			}
+		}
+	}
+}
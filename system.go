@@ -0,0 +1,161 @@
+package gbfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+type (
+	// System is a snapshot of all the feeds published by a provider
+	// A feed is nil when it failed or is not published, see Errors
+	System struct {
+		AutoDiscovery      gbfsspec.FeedGBFS
+		GBFSVersions       *gbfsspec.FeedGBFSVersions
+		SystemInformation  *gbfsspec.FeedSystemInformation
+		StationInformation *gbfsspec.FeedStationInformation
+		StationStatus      *gbfsspec.FeedStationStatus
+		FreeBikeStatus     *gbfsspec.FeedFreeBikeStatus
+		SystemHours        *gbfsspec.FeedSystemHours
+		SystemCalendar     *gbfsspec.FeedSystemCalendars
+		SystemRegions      *gbfsspec.FeedSystemRegions
+		SystemPricingPlans *gbfsspec.FeedSystemPricingPlans
+		SystemAlerts       *gbfsspec.FeedSystemAlerts
+
+		// Error by feed key, for the feeds that failed or are not published (ErrFeedNotExist)
+		Errors map[string]error
+	}
+)
+
+// FetchSystem read the auto-discovery feed, then fetch in parallel every feed listed (in any language),
+// with at most 'concurrency' fetches at the same time (no limit when lower than 1).
+// Only a failure of the auto-discovery or the system_information feeds (required by the spec) return an error,
+// the failure of the others feeds is reported in System.Errors.
+// The feeds are decoded with the spec 2.0, ErrVersionNotSupported is returned when the client know
+// the version of the feeds (see VersionedClient) and it's not a 1.x or 2.x version.
+// When the client doesn't use the auto-discovery (see HTTPOptionAutoDiscovery), the URLs listed for the first
+// language (in alphabetical order) are set on the client with ForceURLs.
+func FetchSystem(ctx context.Context, c Client, concurrency int) (*System, error) {
+	s := &System{Errors: make(map[string]error)}
+
+	discovery := false
+
+	// the errors of the negotiation are returned by the fetch of the auto-discovery feed
+	if vc, ok := c.(VersionedClient); ok {
+		v, err := vc.Negotiate(ctx)
+		if err == nil && !decodableAsV20(v) {
+			return nil, fmt.Errorf("%s: %w", v, ErrVersionNotSupported)
+		}
+
+		discovery = !errors.Is(err, ErrAutoDiscoveryMissing)
+	}
+
+	if err := c.GetContext(ctx, gbfsspec.FeedKeyAutoDiscovery, &s.AutoDiscovery); err != nil {
+		return nil, fmt.Errorf("%s: %w", gbfsspec.FeedKeyAutoDiscovery, err)
+	}
+
+	// without auto-discovery the client build the URLs from its base URL, use the ones listed by the provider
+	if !discovery {
+		if l, err := pickLanguage(s.AutoDiscovery.Data, ""); err == nil {
+			urls := make(map[string]string, len(l.Feeds))
+			for _, f := range l.Feeds {
+				urls[f.Name] = f.URL
+			}
+
+			c.ForceURLs(urls, false)
+		}
+	}
+
+	listed := make(map[string]bool)
+	for _, l := range s.AutoDiscovery.Data.Languages {
+		for _, f := range l.Feeds {
+			listed[f.Name] = true
+		}
+	}
+
+	feeds := []Feed{
+		&gbfsspec.FeedGBFSVersions{},
+		&gbfsspec.FeedSystemInformation{},
+		&gbfsspec.FeedStationInformation{},
+		&gbfsspec.FeedStationStatus{},
+		&gbfsspec.FeedFreeBikeStatus{},
+		&gbfsspec.FeedSystemHours{},
+		&gbfsspec.FeedSystemCalendars{},
+		&gbfsspec.FeedSystemRegions{},
+		&gbfsspec.FeedSystemPricingPlans{},
+		&gbfsspec.FeedSystemAlerts{},
+	}
+
+	if concurrency < 1 {
+		concurrency = len(feeds)
+	}
+
+	errs := make([]error, len(feeds))
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+
+	for i, f := range feeds {
+		if !listed[f.FeedKey()] {
+			errs[i] = ErrFeedNotExist
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(i int, f Feed) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			errs[i] = c.GetContext(ctx, f.FeedKey(), f)
+		}(i, f)
+	}
+
+	wg.Wait()
+
+	for i, f := range feeds {
+		if errs[i] != nil {
+			s.Errors[f.FeedKey()] = errs[i]
+			continue
+		}
+
+		s.set(f)
+	}
+
+	if err, ok := s.Errors[gbfsspec.FeedKeySystemInformation]; ok {
+		return nil, fmt.Errorf("%s: %w", gbfsspec.FeedKeySystemInformation, err)
+	}
+
+	return s, nil
+}
+
+// set the feed in its field
+func (s *System) set(f Feed) {
+	switch f := f.(type) {
+	case *gbfsspec.FeedGBFSVersions:
+		s.GBFSVersions = f
+	case *gbfsspec.FeedSystemInformation:
+		s.SystemInformation = f
+	case *gbfsspec.FeedStationInformation:
+		s.StationInformation = f
+	case *gbfsspec.FeedStationStatus:
+		s.StationStatus = f
+	case *gbfsspec.FeedFreeBikeStatus:
+		s.FreeBikeStatus = f
+	case *gbfsspec.FeedSystemHours:
+		s.SystemHours = f
+	case *gbfsspec.FeedSystemCalendars:
+		s.SystemCalendar = f
+	case *gbfsspec.FeedSystemRegions:
+		s.SystemRegions = f
+	case *gbfsspec.FeedSystemPricingPlans:
+		s.SystemPricingPlans = f
+	case *gbfsspec.FeedSystemAlerts:
+		s.SystemAlerts = f
+	}
+}
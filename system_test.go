@@ -0,0 +1,144 @@
+package gbfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+func newSystemServer(feeds ...string) *httptest.Server {
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+
+	mux.HandleFunc("/gbfs.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"last_updated": 1589230640, "ttl": 0, "data": {"en": {"feeds": [`)
+		for i, f := range feeds {
+			if i > 0 {
+				_, _ = fmt.Fprint(w, ",")
+			}
+			_, _ = fmt.Fprintf(w, `{"name": "%[2]s", "url": "%[1]s/en/%[2]s.json"}`, s.URL, f)
+		}
+		_, _ = fmt.Fprint(w, `]}}}`)
+	})
+	mux.Handle("/en/", http.FileServer(http.Dir("test/gbfs")))
+
+	return s
+}
+
+func TestFetchSystem(t *testing.T) {
+	s := newSystemServer(gbfsspec.FeedKeySystemInformation, gbfsspec.FeedKeyStationStatus)
+	defer s.Close()
+
+	c, err := NewHTTPClient(HTTPOptionAutoDiscovery(s.URL + "/gbfs.json"))
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	sys, err := FetchSystem(context.Background(), c, 2)
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if sys.SystemInformation == nil || sys.SystemInformation.Data.SystemID != "BA" {
+		t.Errorf("expect system information of 'BA' got '%v'", sys.SystemInformation)
+	}
+
+	// listed but not found
+	if err := sys.Errors[gbfsspec.FeedKeyStationStatus]; !errors.Is(err, ErrFeedNotExist) {
+		t.Errorf("expect '%s' got '%s'", ErrFeedNotExist, err)
+	}
+
+	// not listed
+	if err := sys.Errors[gbfsspec.FeedKeySystemAlerts]; !errors.Is(err, ErrFeedNotExist) {
+		t.Errorf("expect '%s' got '%s'", ErrFeedNotExist, err)
+	}
+
+	if sys.StationStatus != nil {
+		t.Errorf("expect 'nil' got '%v'", sys.StationStatus)
+	}
+
+	if l := len(sys.Errors); l != 9 {
+		t.Errorf("expect '9' got '%d'", l)
+	}
+}
+
+func TestFetchSystem_requiredFeed(t *testing.T) {
+	s := newSystemServer(gbfsspec.FeedKeyStationStatus)
+	defer s.Close()
+
+	c, err := NewHTTPClient(HTTPOptionAutoDiscovery(s.URL + "/gbfs.json"))
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if _, err := FetchSystem(context.Background(), c, 0); !errors.Is(err, ErrFeedNotExist) {
+		t.Errorf("expect '%s' got '%s'", ErrFeedNotExist, err)
+	}
+}
+
+func TestFetchSystem_conditionalRequests(t *testing.T) {
+	s := newSystemServer(gbfsspec.FeedKeySystemInformation)
+	defer s.Close()
+
+	c, err := NewHTTPClient(
+		HTTPOptionAutoDiscovery(s.URL+"/gbfs.json"),
+		HTTPOptionConditionalRequests(),
+	)
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	// the second snapshot is fetched in new structures, the feeds are never "not modified"
+	for i := 0; i < 2; i++ {
+		sys, err := FetchSystem(context.Background(), c, 2)
+		if err != nil {
+			t.Errorf("expect 'nil' got '%s'", err)
+			t.FailNow()
+		}
+
+		if sys.SystemInformation == nil || sys.SystemInformation.Data.SystemID != "BA" {
+			t.Errorf("expect system information of 'BA' got '%v'", sys.SystemInformation)
+		}
+	}
+}
+
+func TestFetchSystem_baseURL(t *testing.T) {
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	// the URLs listed don't follow the naming convention of the base URL
+	mux.HandleFunc("/gbfs.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"last_updated": 1589230640, "ttl": 0, "data": {"en": {"feeds": [
+			{"name": "system_information", "url": "%s/custom/si"}
+		]}}}`, s.URL)
+	})
+	mux.HandleFunc("/custom/si", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, "test/gbfs/en/system_information.json")
+	})
+
+	c, err := NewHTTPClient(HTTPOptionBaseURL(s.URL))
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	sys, err := FetchSystem(context.Background(), NewCachingClient(c), 2)
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	if sys.SystemInformation == nil || sys.SystemInformation.Data.SystemID != "BA" {
+		t.Errorf("expect system information of 'BA' got '%v'", sys.SystemInformation)
+	}
+}
@@ -0,0 +1,305 @@
+package validate
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/Eraac/gbfs"
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+var (
+	// Hours can go past midnight, up to 47:59:59
+	timeRegexp     = regexp.MustCompile(`^([0-3][0-9]|4[0-7]):[0-5][0-9]:[0-5][0-9]$`)
+	currencyRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+var (
+	rentalMethods = map[gbfsspec.RentalMethod]bool{
+		gbfsspec.RentalMethodKey:           true,
+		gbfsspec.RentalMethodCreditCard:    true,
+		gbfsspec.RentalMethodPayPass:       true,
+		gbfsspec.RentalMethodApplePay:      true,
+		gbfsspec.RentalMethodAndroidPay:    true,
+		gbfsspec.RentalMethodTransitCard:   true,
+		gbfsspec.RentalMethodAccountNumber: true,
+		gbfsspec.RentalMethodPhone:         true,
+	}
+
+	alertTypes = map[gbfsspec.AlertType]bool{
+		gbfsspec.AlertTypeSystemClosure:  true,
+		gbfsspec.AlertTypeStationClosure: true,
+		gbfsspec.AlertTypeStationMove:    true,
+		gbfsspec.AlertTypeOther:          true,
+	}
+
+	userTypes = map[gbfsspec.UserType]bool{
+		gbfsspec.UserTypeMember:    true,
+		gbfsspec.UserTypeNonMember: true,
+	}
+
+	days = map[gbfsspec.Day]bool{
+		gbfsspec.DayMonday:    true,
+		gbfsspec.DayTuesday:   true,
+		gbfsspec.DayWednesday: true,
+		gbfsspec.DayThursday:  true,
+		gbfsspec.DayFriday:    true,
+		gbfsspec.DaySaturday:  true,
+		gbfsspec.DaySunday:    true,
+	}
+)
+
+// Feed validate a decoded feed, the feeds without validator return no violation
+func Feed(f gbfs.Feed) Violations {
+	switch f := f.(type) {
+	case *gbfsspec.FeedSystemInformation:
+		return SystemInformation(f)
+	case *gbfsspec.FeedStationInformation:
+		return StationInformation(f)
+	case *gbfsspec.FeedStationStatus:
+		return StationStatus(f)
+	case *gbfsspec.FeedFreeBikeStatus:
+		return FreeBikeStatus(f)
+	case *gbfsspec.FeedSystemHours:
+		return SystemHours(f)
+	case *gbfsspec.FeedSystemRegions:
+		return SystemRegions(f)
+	case *gbfsspec.FeedSystemPricingPlans:
+		return SystemPricingPlans(f)
+	case *gbfsspec.FeedSystemAlerts:
+		return SystemAlerts(f)
+	}
+
+	return nil
+}
+
+func (c *collector) metadata(m gbfsspec.Metadata) {
+	if m.LastUpdated <= 0 {
+		c.add("last_updated", SeverityError, RuleRequired, "required field is missing or not a POSIX timestamp")
+	}
+
+	c.nonNegative("ttl", m.TTL)
+}
+
+// SystemInformation validate the system_information feed
+func SystemInformation(f *gbfsspec.FeedSystemInformation) Violations {
+	var c collector
+
+	c.metadata(f.Metadata)
+
+	d := f.Data
+	c.required("data.system_id", d.SystemID)
+	c.required("data.language", d.Language)
+	c.required("data.name", d.Name)
+	c.required("data.timezone", d.Timezone)
+
+	if d.StartDate != "" {
+		if _, err := d.StartDate.ToTime(""); err != nil {
+			c.add("data.start_date", SeverityError, RuleFormat, "must be a date YYYY-MM-DD, got '%s'", d.StartDate)
+		}
+	}
+
+	return c.vv
+}
+
+// StationInformation validate the station_information feed
+func StationInformation(f *gbfsspec.FeedStationInformation) Violations {
+	var c collector
+
+	c.metadata(f.Metadata)
+
+	seen := make(map[string]bool, len(f.Data.Stations))
+
+	for i, s := range f.Data.Stations {
+		p := index("data.stations", i)
+
+		c.required(p+".station_id", s.StationID)
+		c.unique(p+".station_id", s.StationID, seen)
+		c.required(p+".name", s.Name)
+		c.position(p, s.Latitude, s.Longitude)
+		c.nonNegative(p+".capacity", s.Capacity)
+
+		for j, m := range s.RentalMethods {
+			if !rentalMethods[m] {
+				c.add(index(p+".rental_methods", j), SeverityError, RuleEnum, "unknown rental method '%s'", m)
+			}
+		}
+	}
+
+	return c.vv
+}
+
+// StationStatus validate the station_status feed
+func StationStatus(f *gbfsspec.FeedStationStatus) Violations {
+	var c collector
+
+	c.metadata(f.Metadata)
+
+	seen := make(map[string]bool, len(f.Data.Stations))
+
+	for i, s := range f.Data.Stations {
+		p := index("data.stations", i)
+
+		c.required(p+".station_id", s.StationID)
+		c.unique(p+".station_id", s.StationID, seen)
+		c.nonNegative(p+".num_bikes_available", s.NumBikesAvailable)
+		c.nonNegative(p+".num_bikes_disabled", s.NumBikesDisabled)
+		c.nonNegative(p+".num_docks_available", s.NumDocksAvailable)
+		c.nonNegative(p+".num_docks_disabled", s.NumDocksDisabled)
+
+		if s.LastReported <= 0 {
+			c.add(p+".last_reported", SeverityError, RuleRequired, "required field is missing or not a POSIX timestamp")
+		}
+	}
+
+	return c.vv
+}
+
+// FreeBikeStatus validate the free_bike_status feed
+func FreeBikeStatus(f *gbfsspec.FeedFreeBikeStatus) Violations {
+	var c collector
+
+	c.metadata(f.Metadata)
+
+	seen := make(map[string]bool, len(f.Data.Bikes))
+
+	for i, b := range f.Data.Bikes {
+		p := index("data.bikes", i)
+
+		c.required(p+".bike_id", b.BikeID)
+		c.unique(p+".bike_id", b.BikeID, seen)
+		c.position(p, b.Latitude, b.Longitude)
+	}
+
+	return c.vv
+}
+
+// SystemHours validate the system_hours feed
+func SystemHours(f *gbfsspec.FeedSystemHours) Violations {
+	var c collector
+
+	c.metadata(f.Metadata)
+
+	for i, h := range f.Data.RentalHours {
+		p := index("data.rental_hours", i)
+
+		if len(h.UserTypes) == 0 {
+			c.add(p+".user_types", SeverityError, RuleRequired, "at least one user type is required")
+		}
+
+		for j, u := range h.UserTypes {
+			if !userTypes[u] {
+				c.add(index(p+".user_types", j), SeverityError, RuleEnum, "unknown user type '%s'", u)
+			}
+		}
+
+		if len(h.Days) == 0 {
+			c.add(p+".days", SeverityError, RuleRequired, "at least one day is required")
+		}
+
+		for j, d := range h.Days {
+			if !days[d] {
+				c.add(index(p+".days", j), SeverityError, RuleEnum, "unknown day '%s'", d)
+			}
+		}
+
+		for _, t := range []struct {
+			path string
+			v    gbfsspec.Time
+		}{{p + ".start_time", h.StartTime}, {p + ".end_time", h.EndTime}} {
+			if !timeRegexp.MatchString(string(t.v)) {
+				c.add(t.path, SeverityError, RuleFormat, "must be a time HH:MM:SS, got '%s'", t.v)
+			}
+		}
+	}
+
+	return c.vv
+}
+
+// SystemRegions validate the system_regions feed
+func SystemRegions(f *gbfsspec.FeedSystemRegions) Violations {
+	var c collector
+
+	c.metadata(f.Metadata)
+
+	seen := make(map[string]bool, len(f.Data.Regions))
+
+	for i, r := range f.Data.Regions {
+		p := index("data.regions", i)
+
+		c.required(p+".region_id", r.RegionID)
+		c.unique(p+".region_id", r.RegionID, seen)
+		c.required(p+".name", r.Name)
+	}
+
+	return c.vv
+}
+
+// SystemPricingPlans validate the system_pricing_plans feed
+func SystemPricingPlans(f *gbfsspec.FeedSystemPricingPlans) Violations {
+	var c collector
+
+	c.metadata(f.Metadata)
+
+	seen := make(map[string]bool, len(f.Data.Plans))
+
+	for i, pl := range f.Data.Plans {
+		p := index("data.plans", i)
+
+		c.required(p+".plan_id", pl.PlanID)
+		c.unique(p+".plan_id", pl.PlanID, seen)
+		c.required(p+".name", pl.Name)
+		c.required(p+".description", pl.Description)
+
+		if !currencyRegexp.MatchString(pl.Currency) {
+			c.add(p+".currency", SeverityError, RuleFormat, "must be an ISO 4217 code, got '%s'", pl.Currency)
+		}
+
+		c.required(p+".price", string(pl.Price))
+
+		if pl.Price != "" {
+			if v, err := strconv.ParseFloat(string(pl.Price), 64); err != nil {
+				c.add(p+".price", SeverityError, RuleType, "must be a number, got '%s'", pl.Price)
+			} else if v < 0 {
+				c.add(p+".price", SeverityError, RuleRange, "must be non-negative, got '%s'", pl.Price)
+			}
+		}
+	}
+
+	return c.vv
+}
+
+// SystemAlerts validate the system_alerts feed
+func SystemAlerts(f *gbfsspec.FeedSystemAlerts) Violations {
+	var c collector
+
+	c.metadata(f.Metadata)
+
+	seen := make(map[string]bool, len(f.Data.Alerts))
+
+	for i, a := range f.Data.Alerts {
+		p := index("data.alerts", i)
+
+		c.required(p+".alert_id", a.AlertID)
+		c.unique(p+".alert_id", a.AlertID, seen)
+		c.required(p+".summary", a.Summary)
+
+		if !alertTypes[a.Type] {
+			c.add(p+".type", SeverityError, RuleEnum, "unknown alert type '%s'", a.Type)
+		}
+
+		for j, t := range a.Times {
+			tp := index(p+".times", j)
+
+			if t.Start <= 0 {
+				c.add(tp+".start", SeverityError, RuleRequired, "required field is missing or not a POSIX timestamp")
+			}
+
+			if t.End != 0 && t.End < t.Start {
+				c.add(tp+".end", SeverityError, RuleRange, "must be after the start")
+			}
+		}
+	}
+
+	return c.vv
+}
@@ -0,0 +1,142 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/Eraac/gbfs"
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+var metadata = gbfsspec.Metadata{LastUpdated: 1589230640, TTL: 60}
+
+func TestStationInformation(t *testing.T) {
+	vv := StationInformation(&gbfsspec.FeedStationInformation{Metadata: metadata, Data: gbfsspec.StationInformationData{
+		Stations: []gbfsspec.StationInformation{
+			{StationID: "1", Name: "Market St", Latitude: 37.7, Longitude: -122.4, RentalMethods: []gbfsspec.RentalMethod{"KEY", "CASH"}},
+			{StationID: "1", Name: "Mission St", Latitude: 91, Longitude: -122.4},
+			{Name: "Howard St", Capacity: -1},
+		},
+	}})
+
+	ii := []struct {
+		path, rule string
+	}{
+		{path: "data.stations[0].rental_methods[1]", rule: RuleEnum},
+		{path: "data.stations[1].station_id", rule: RuleUnique},
+		{path: "data.stations[1].lat", rule: RuleRange},
+		{path: "data.stations[2].station_id", rule: RuleRequired},
+		{path: "data.stations[2].capacity", rule: RuleRange},
+		{path: "data.stations[2]", rule: RuleRange},
+	}
+
+	for _, i := range ii {
+		if !has(vv, i.path, i.rule) {
+			t.Errorf("expect '%s (%s)' got '%v'", i.path, i.rule, vv)
+		}
+	}
+
+	if l := len(vv); l != len(ii) {
+		t.Errorf("expect '%d' got '%d' (%v)", len(ii), l, vv)
+	}
+}
+
+func TestStationStatus(t *testing.T) {
+	vv := StationStatus(&gbfsspec.FeedStationStatus{Data: gbfsspec.StationStatusData{
+		Stations: []gbfsspec.StationStatus{
+			{StationID: "1", NumBikesAvailable: -2, LastReported: 1589230640},
+			{StationID: "2"},
+		},
+	}})
+
+	ii := []struct {
+		path, rule string
+	}{
+		{path: "last_updated", rule: RuleRequired},
+		{path: "data.stations[0].num_bikes_available", rule: RuleRange},
+		{path: "data.stations[1].last_reported", rule: RuleRequired},
+	}
+
+	for _, i := range ii {
+		if !has(vv, i.path, i.rule) {
+			t.Errorf("expect '%s (%s)' got '%v'", i.path, i.rule, vv)
+		}
+	}
+}
+
+func TestFeed(t *testing.T) {
+	ii := []struct {
+		in         gbfs.Feed
+		path, rule string
+	}{
+		{
+			in:   &gbfsspec.FeedSystemInformation{Metadata: metadata, Data: gbfsspec.SystemInformationData{SystemID: "BA", Language: "en", Name: "Bay Wheels", StartDate: "2017/06/28"}},
+			path: "data.timezone", rule: RuleRequired,
+		},
+		{
+			in:   &gbfsspec.FeedSystemInformation{Metadata: metadata, Data: gbfsspec.SystemInformationData{StartDate: "2017/06/28"}},
+			path: "data.start_date", rule: RuleFormat,
+		},
+		{
+			in:   &gbfsspec.FeedFreeBikeStatus{Metadata: metadata, Data: gbfsspec.FreeBikeStatusData{Bikes: []gbfsspec.FreeBikeStatus{{BikeID: "b1", Longitude: 200}}}},
+			path: "data.bikes[0].lon", rule: RuleRange,
+		},
+		{
+			in: &gbfsspec.FeedSystemHours{Metadata: metadata, Data: gbfsspec.SystemHoursData{RentalHours: []gbfsspec.SystemHoursRentalHours{
+				{UserTypes: []gbfsspec.UserType{"member"}, Days: []gbfsspec.Day{"mon", "monday"}, StartTime: "06:00:00", EndTime: "26:00:00"},
+			}}},
+			path: "data.rental_hours[0].days[1]", rule: RuleEnum,
+		},
+		{
+			in: &gbfsspec.FeedSystemHours{Metadata: metadata, Data: gbfsspec.SystemHoursData{RentalHours: []gbfsspec.SystemHoursRentalHours{
+				{UserTypes: []gbfsspec.UserType{"member"}, Days: []gbfsspec.Day{"mon"}, StartTime: "6:00", EndTime: "26:00:00"},
+			}}},
+			path: "data.rental_hours[0].start_time", rule: RuleFormat,
+		},
+		{
+			in:   &gbfsspec.FeedSystemRegions{Metadata: metadata, Data: gbfsspec.SystemRegionsData{Regions: []gbfsspec.SystemRegion{{RegionID: "r1"}}}},
+			path: "data.regions[0].name", rule: RuleRequired,
+		},
+		{
+			in: &gbfsspec.FeedSystemPricingPlans{Metadata: metadata, Data: gbfsspec.SystemPricingPlansData{Plans: []gbfsspec.SystemPricingPlan{
+				{PlanID: "p1", Name: "Single", Description: "Single ride", Currency: "usd", Price: "2"},
+			}}},
+			path: "data.plans[0].currency", rule: RuleFormat,
+		},
+		{
+			in: &gbfsspec.FeedSystemPricingPlans{Metadata: metadata, Data: gbfsspec.SystemPricingPlansData{Plans: []gbfsspec.SystemPricingPlan{
+				{PlanID: "p1", Name: "Single", Description: "Single ride", Currency: "USD", Price: "free"},
+			}}},
+			path: "data.plans[0].price", rule: RuleType,
+		},
+		{
+			in: &gbfsspec.FeedSystemAlerts{Metadata: metadata, Data: gbfsspec.SystemAlertsData{Alerts: []gbfsspec.SystemAlert{
+				{AlertID: "a1", Type: "CLOSED", Summary: "Closed"},
+			}}},
+			path: "data.alerts[0].type", rule: RuleEnum,
+		},
+		{
+			in: &gbfsspec.FeedSystemAlerts{Metadata: metadata, Data: gbfsspec.SystemAlertsData{Alerts: []gbfsspec.SystemAlert{
+				{AlertID: "a1", Type: gbfsspec.AlertTypeOther, Summary: "Works", Times: []gbfsspec.SystemAlertTime{{Start: 20, End: 10}}},
+			}}},
+			path: "data.alerts[0].times[0].end", rule: RuleRange,
+		},
+	}
+
+	for _, i := range ii {
+		vv := Feed(i.in)
+
+		if !has(vv, i.path, i.rule) {
+			t.Errorf("expect '%s (%s)' got '%v'", i.path, i.rule, vv)
+		}
+	}
+}
+
+func TestFeed_compliant(t *testing.T) {
+	vv := Feed(&gbfsspec.FeedSystemInformation{Metadata: metadata, Data: gbfsspec.SystemInformationData{
+		SystemID: "BA", Language: "en", Name: "Bay Wheels", Timezone: "America/Los_Angeles", StartDate: "2017-06-28",
+	}})
+
+	if len(vv) != 0 {
+		t.Errorf("expect '0' got '%v'", vv)
+	}
+}
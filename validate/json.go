@@ -0,0 +1,159 @@
+package validate
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Eraac/gbfs"
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+var feeds = map[string]func() gbfs.Feed{
+	gbfsspec.FeedKeySystemInformation:  func() gbfs.Feed { return &gbfsspec.FeedSystemInformation{} },
+	gbfsspec.FeedKeyStationInformation: func() gbfs.Feed { return &gbfsspec.FeedStationInformation{} },
+	gbfsspec.FeedKeyStationStatus:      func() gbfs.Feed { return &gbfsspec.FeedStationStatus{} },
+	gbfsspec.FeedKeyFreeBikeStatus:     func() gbfs.Feed { return &gbfsspec.FeedFreeBikeStatus{} },
+	gbfsspec.FeedKeySystemHours:        func() gbfs.Feed { return &gbfsspec.FeedSystemHours{} },
+	gbfsspec.FeedKeySystemRegions:      func() gbfs.Feed { return &gbfsspec.FeedSystemRegions{} },
+	gbfsspec.FeedKeySystemPricingPlans: func() gbfs.Feed { return &gbfsspec.FeedSystemPricingPlans{} },
+	gbfsspec.FeedKeySystemAlerts:       func() gbfs.Feed { return &gbfsspec.FeedSystemAlerts{} },
+}
+
+// JSON validate the raw JSON of the feed 'key'
+// In addition of the validator of the decoded feed, the presence of the required fields and their types are checked
+func JSON(key string, raw []byte) Violations {
+	var c collector
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		c.add("", SeverityError, RuleSyntax, "invalid JSON object: %s", err)
+		return c.vv
+	}
+
+	for _, k := range gbfsspec.RequiredRootFields {
+		if _, ok := doc[k]; !ok {
+			c.add(k, SeverityError, RuleRequired, "required field is missing")
+		}
+	}
+
+	required := gbfsspec.RequiredFields[key]
+
+	patterns := make([]string, 0, len(required))
+	for p := range required {
+		patterns = append(patterns, p)
+	}
+
+	sort.Strings(patterns)
+
+	for _, p := range patterns {
+		missing(&c, "", doc, strings.Split(p, "."), required[p])
+	}
+
+	newFeed, ok := feeds[key]
+	if !ok {
+		return c.vv
+	}
+
+	f := newFeed()
+
+	if err := json.Unmarshal(raw, f); err != nil {
+		var terr *json.UnmarshalTypeError
+		if !errors.As(err, &terr) {
+			c.add("", SeverityError, RuleSyntax, "%s", err)
+			return c.vv
+		}
+
+		c.add(fieldPath(terr.Field), SeverityError, RuleType, "must be a %s, got %s", terr.Type, terr.Value)
+	}
+
+	// the fields already reported missing are not reported again
+	reported := make(map[string]bool, len(c.vv))
+	for _, v := range c.vv {
+		reported[v.Path] = true
+	}
+
+	for _, v := range Feed(f) {
+		if !(v.Rule == RuleRequired && reported[v.Path]) {
+			c.vv = append(c.vv, v)
+		}
+	}
+
+	return c.vv
+}
+
+// missing report the required 'fields' absent from the objects at the path 'segments' of the JSON value 'v'
+// The segments ending with [] are arrays of objects, each element is checked
+func missing(c *collector, path string, v interface{}, segments, fields []string) {
+	o, ok := v.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	if len(segments) == 0 {
+		for _, f := range fields {
+			if _, ok := o[f]; !ok {
+				c.add(joinPath(path, f), SeverityError, RuleRequired, "required field is missing")
+			}
+		}
+
+		return
+	}
+
+	name := strings.TrimSuffix(segments[0], "[]")
+
+	child, ok := o[name]
+	if !ok {
+		return
+	}
+
+	if name == segments[0] {
+		missing(c, joinPath(path, name), child, segments[1:], fields)
+		return
+	}
+
+	items, _ := child.([]interface{})
+
+	for i, item := range items {
+		p := index(joinPath(path, name), i)
+
+		// the elements are reported once, by the pattern of the array itself
+		if _, ok := item.(map[string]interface{}); !ok && len(segments) == 1 {
+			c.add(p, SeverityError, RuleType, "must be an object")
+			continue
+		}
+
+		missing(c, p, item, segments[1:], fields)
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// fieldPath format the path of a decoding error with the indexes between brackets (data.stations.0.lat)
+// The indexes are absent with the older versions of Go (data.stations.lat)
+func fieldPath(f string) string {
+	var b strings.Builder
+
+	for i, s := range strings.Split(f, ".") {
+		if _, err := strconv.Atoi(s); err == nil {
+			b.WriteString("[" + s + "]")
+			continue
+		}
+
+		if i > 0 {
+			b.WriteString(".")
+		}
+
+		b.WriteString(s)
+	}
+
+	return b.String()
+}
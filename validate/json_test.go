@@ -0,0 +1,114 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+func TestJSON(t *testing.T) {
+	ii := []struct {
+		key, in    string
+		path, rule string
+	}{
+		{
+			key: gbfsspec.FeedKeyStationStatus, in: `{"last_updated": 1589230640`,
+			path: "", rule: RuleSyntax,
+		},
+		{
+			key: gbfsspec.FeedKeyStationStatus, in: `{"last_updated": 1589230640, "data": {"stations": []}}`,
+			path: "ttl", rule: RuleRequired,
+		},
+		{
+			key: gbfsspec.FeedKeyStationStatus,
+			in: `{"last_updated": 1589230640, "ttl": 0, "data": {"stations": [
+				{"station_id": "1", "num_docks_available": 2, "is_installed": 1, "is_renting": 1, "is_returning": 1, "last_reported": 1589230640}
+			]}}`,
+			path: "data.stations[0].num_bikes_available", rule: RuleRequired,
+		},
+		{
+			key: gbfsspec.FeedKeyStationStatus,
+			in: `{"last_updated": 1589230640, "ttl": 0, "data": {"stations": [
+				{"station_id": "1", "num_bikes_available": "2", "num_docks_available": 2, "is_installed": 1, "is_renting": 1, "is_returning": 1, "last_reported": 1589230640}
+			]}}`,
+			path: "data.stations[0].num_bikes_available", rule: RuleType,
+		},
+		{
+			key:  gbfsspec.FeedKeyStationInformation,
+			in:   `{"last_updated": 1589230640, "ttl": 0, "data": {"stations": [{"name": "Market St", "lat": 37.7, "lon": 190}]}}`,
+			path: "data.stations[0].lon", rule: RuleRange,
+		},
+	}
+
+	for _, i := range ii {
+		vv := JSON(i.key, []byte(i.in))
+
+		// the indexes are absent from the path of the decoding errors with the older versions of Go
+		if !has(vv, i.path, i.rule) && !has(vv, strings.Replace(i.path, "[0]", "", 1), i.rule) {
+			t.Errorf("expect '%s (%s)' got '%v'", i.path, i.rule, vv)
+		}
+	}
+}
+
+func TestJSON_requiredReportedOnce(t *testing.T) {
+	vv := JSON(gbfsspec.FeedKeyStationInformation, []byte(`{"last_updated": 1589230640, "ttl": 0, "data": {"stations": [{"name": "Market St", "lat": 37.7, "lon": -122.4}]}}`))
+
+	if l := len(vv); l != 1 || !has(vv, "data.stations[0].station_id", RuleRequired) {
+		t.Errorf("expect '1' got '%d' (%v)", l, vv)
+	}
+}
+
+func TestJSON_requiredNested(t *testing.T) {
+	ii := []struct {
+		key, in string
+		path    string
+	}{
+		{
+			key:  gbfsspec.FeedKeySystemInformation,
+			in:   `{"last_updated": 1589230640, "ttl": 0, "data": {"system_id": "BA", "language": "en", "timezone": "UTC"}}`,
+			path: "data.name",
+		},
+		{
+			key:  gbfsspec.FeedKeyStationStatus,
+			in:   `{"last_updated": 1589230640, "ttl": 0, "data": {}}`,
+			path: "data.stations",
+		},
+		{
+			key:  gbfsspec.FeedKeySystemAlerts,
+			in:   `{"last_updated": 1589230640, "ttl": 0, "data": {"alerts": [{"alert_id": "1", "type": "OTHER", "summary": "Closed", "times": [{"end": 1589230640}]}]}}`,
+			path: "data.alerts[0].times[0].start",
+		},
+	}
+
+	for _, i := range ii {
+		vv := JSON(i.key, []byte(i.in))
+
+		var n int
+		for _, v := range vv {
+			if v.Path == i.path && v.Rule == RuleRequired {
+				n++
+			}
+		}
+
+		if n != 1 {
+			t.Errorf("expect '%s (%s)' once got '%v'", i.path, RuleRequired, vv)
+		}
+	}
+}
+
+func TestFieldPath(t *testing.T) {
+	ii := []struct {
+		in, out string
+	}{
+		{in: "data.stations.0.lat", out: "data.stations[0].lat"},
+		{in: "data.stations.lat", out: "data.stations.lat"},
+		{in: "ttl", out: "ttl"},
+	}
+
+	for _, i := range ii {
+		if o := fieldPath(i.in); o != i.out {
+			t.Errorf("expect '%s' got '%s'", i.out, o)
+		}
+	}
+}
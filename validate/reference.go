@@ -0,0 +1,35 @@
+package validate
+
+import gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+
+// StationReferences check the stations of station_status are described by station_information
+// A station of station_information without status is reported as a warning
+// The paths are relative to station_status, prefixed by the feed key
+func StationReferences(info *gbfsspec.FeedStationInformation, status *gbfsspec.FeedStationStatus) Violations {
+	var c collector
+
+	known := make(map[string]bool, len(info.Data.Stations))
+	for _, s := range info.Data.Stations {
+		known[s.StationID] = true
+	}
+
+	reported := make(map[string]bool, len(status.Data.Stations))
+
+	for i, s := range status.Data.Stations {
+		reported[s.StationID] = true
+
+		if !known[s.StationID] {
+			c.add(index(gbfsspec.FeedKeyStationStatus+".data.stations", i)+".station_id", SeverityError, RuleReference,
+				"station '%s' is not in %s", s.StationID, gbfsspec.FeedKeyStationInformation)
+		}
+	}
+
+	for i, s := range info.Data.Stations {
+		if !reported[s.StationID] {
+			c.add(index(gbfsspec.FeedKeyStationInformation+".data.stations", i)+".station_id", SeverityWarning, RuleReference,
+				"station '%s' is not in %s", s.StationID, gbfsspec.FeedKeyStationStatus)
+		}
+	}
+
+	return c.vv
+}
@@ -0,0 +1,31 @@
+package validate
+
+import (
+	"testing"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+func TestStationReferences(t *testing.T) {
+	info := &gbfsspec.FeedStationInformation{Data: gbfsspec.StationInformationData{
+		Stations: []gbfsspec.StationInformation{{StationID: "1"}, {StationID: "2"}},
+	}}
+
+	status := &gbfsspec.FeedStationStatus{Data: gbfsspec.StationStatusData{
+		Stations: []gbfsspec.StationStatus{{StationID: "1"}, {StationID: "3"}},
+	}}
+
+	vv := StationReferences(info, status)
+
+	if l := len(vv); l != 2 {
+		t.Errorf("expect '2' got '%d' (%v)", l, vv)
+	}
+
+	if !has(vv, "station_status.data.stations[1].station_id", RuleReference) || !vv.HasErrors() {
+		t.Errorf("expect unknown station '3' got '%v'", vv)
+	}
+
+	if !has(vv, "station_information.data.stations[1].station_id", RuleReference) {
+		t.Errorf("expect station '2' without status got '%v'", vv)
+	}
+}
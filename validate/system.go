@@ -0,0 +1,87 @@
+package validate
+
+import (
+	"github.com/Eraac/gbfs"
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+// System check the references between the feeds of the system, a feed not published is considered empty
+// The paths are prefixed by the feed key (e.g. station_status.data.stations[2].station_id)
+//   - the stations of station_status are in station_information (see StationReferences)
+//   - the regions of station_information are in system_regions
+//   - the stations and regions of system_alerts exist
+//   - the language of system_information is listed by gbfs.json
+//   - the vehicles and docks available don't exceed the capacity of the station
+func System(s *gbfs.System) Violations {
+	var c collector
+
+	info := s.StationInformation
+	if info == nil {
+		info = &gbfsspec.FeedStationInformation{}
+	}
+
+	stations := make(map[string]gbfsspec.StationInformation, len(info.Data.Stations))
+	for _, st := range info.Data.Stations {
+		stations[st.StationID] = st
+	}
+
+	regions := make(map[string]bool)
+	if s.SystemRegions != nil {
+		for _, r := range s.SystemRegions.Data.Regions {
+			regions[r.RegionID] = true
+		}
+	}
+
+	if s.StationStatus != nil {
+		c.vv = append(c.vv, StationReferences(info, s.StationStatus)...)
+
+		for i, st := range s.StationStatus.Data.Stations {
+			capacity := stations[st.StationID].Capacity
+
+			// the capacity is optional
+			if capacity > 0 && st.NumBikesAvailable+st.NumDocksAvailable > capacity {
+				c.add(index(gbfsspec.FeedKeyStationStatus+".data.stations", i), SeverityError, RuleConsistency,
+					"%d bikes and %d docks available exceed the capacity (%d) of the station '%s'",
+					st.NumBikesAvailable, st.NumDocksAvailable, capacity, st.StationID)
+			}
+		}
+	}
+
+	for i, st := range info.Data.Stations {
+		if st.RegionID != "" && !regions[st.RegionID] {
+			c.add(index(gbfsspec.FeedKeyStationInformation+".data.stations", i)+".region_id", SeverityError, RuleReference,
+				"region '%s' is not in %s", st.RegionID, gbfsspec.FeedKeySystemRegions)
+		}
+	}
+
+	if s.SystemAlerts != nil {
+		for i, a := range s.SystemAlerts.Data.Alerts {
+			p := index(gbfsspec.FeedKeySystemAlerts+".data.alerts", i)
+
+			for j, id := range a.StationIDs {
+				if _, ok := stations[id]; !ok {
+					c.add(index(p+".station_ids", j), SeverityError, RuleReference,
+						"station '%s' is not in %s", id, gbfsspec.FeedKeyStationInformation)
+				}
+			}
+
+			for j, id := range a.RegionIDs {
+				if !regions[id] {
+					c.add(index(p+".region_ids", j), SeverityError, RuleReference,
+						"region '%s' is not in %s", id, gbfsspec.FeedKeySystemRegions)
+				}
+			}
+		}
+	}
+
+	if s.SystemInformation != nil {
+		lang := s.SystemInformation.Data.Language
+
+		if _, ok := s.AutoDiscovery.Data.Languages[lang]; !ok {
+			c.add(gbfsspec.FeedKeySystemInformation+".data.language", SeverityError, RuleConsistency,
+				"language '%s' is not listed by %s", lang, gbfsspec.FeedKeyAutoDiscovery)
+		}
+	}
+
+	return c.vv
+}
@@ -0,0 +1,84 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/Eraac/gbfs"
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+func newSystem() *gbfs.System {
+	return &gbfs.System{
+		AutoDiscovery: gbfsspec.FeedGBFS{Data: gbfsspec.GBFSData{Languages: map[string]gbfsspec.GBFSLanguage{"en": {}}}},
+		SystemInformation: &gbfsspec.FeedSystemInformation{Data: gbfsspec.SystemInformationData{
+			Language: "en",
+		}},
+		StationInformation: &gbfsspec.FeedStationInformation{Data: gbfsspec.StationInformationData{
+			Stations: []gbfsspec.StationInformation{
+				{StationID: "1", RegionID: "r1", Capacity: 10},
+				{StationID: "2"},
+			},
+		}},
+		StationStatus: &gbfsspec.FeedStationStatus{Data: gbfsspec.StationStatusData{
+			Stations: []gbfsspec.StationStatus{
+				{StationID: "1", NumBikesAvailable: 4, NumDocksAvailable: 6},
+				{StationID: "2", NumBikesAvailable: 40, NumDocksAvailable: 60},
+			},
+		}},
+		SystemRegions: &gbfsspec.FeedSystemRegions{Data: gbfsspec.SystemRegionsData{
+			Regions: []gbfsspec.SystemRegion{{RegionID: "r1"}},
+		}},
+		SystemAlerts: &gbfsspec.FeedSystemAlerts{Data: gbfsspec.SystemAlertsData{
+			Alerts: []gbfsspec.SystemAlert{{StationIDs: []string{"1"}, RegionIDs: []string{"r1"}}},
+		}},
+	}
+}
+
+func TestSystem(t *testing.T) {
+	if vv := System(newSystem()); len(vv) != 0 {
+		t.Errorf("expect '0' got '%v'", vv)
+	}
+
+	ii := []struct {
+		alter      func(s *gbfs.System)
+		path, rule string
+	}{
+		{
+			alter: func(s *gbfs.System) { s.StationStatus.Data.Stations[0].StationID = "3" },
+			path:  "station_status.data.stations[0].station_id", rule: RuleReference,
+		},
+		{
+			alter: func(s *gbfs.System) { s.StationInformation.Data.Stations[1].RegionID = "r2" },
+			path:  "station_information.data.stations[1].region_id", rule: RuleReference,
+		},
+		{
+			alter: func(s *gbfs.System) { s.SystemRegions = nil },
+			path:  "station_information.data.stations[0].region_id", rule: RuleReference,
+		},
+		{
+			alter: func(s *gbfs.System) { s.SystemAlerts.Data.Alerts[0].StationIDs = []string{"1", "9"} },
+			path:  "system_alerts.data.alerts[0].station_ids[1]", rule: RuleReference,
+		},
+		{
+			alter: func(s *gbfs.System) { s.SystemAlerts.Data.Alerts[0].RegionIDs = []string{"r9"} },
+			path:  "system_alerts.data.alerts[0].region_ids[0]", rule: RuleReference,
+		},
+		{
+			alter: func(s *gbfs.System) { s.SystemInformation.Data.Language = "fr" },
+			path:  "system_information.data.language", rule: RuleConsistency,
+		},
+		{
+			alter: func(s *gbfs.System) { s.StationStatus.Data.Stations[0].NumDocksAvailable = 7 },
+			path:  "station_status.data.stations[0]", rule: RuleConsistency,
+		},
+	}
+
+	for _, i := range ii {
+		s := newSystem()
+		i.alter(s)
+
+		if vv := System(s); !has(vv, i.path, i.rule) {
+			t.Errorf("expect '%s (%s)' got '%v'", i.path, i.rule, vv)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Package validate check the compliance of the feeds with the spec (version 2.0)
+//
+// Each validator return the list of violations found, with the JSON path of the field, the severity and the rule
+// broken. A feed is compliant when there is no violation with the SeverityError.
+package validate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Severity of a violation
+type Severity string
+
+const (
+	// SeverityError the feed doesn't respect the spec
+	SeverityError Severity = "error"
+
+	// SeverityWarning the feed respect the spec, but the value is suspicious
+	SeverityWarning Severity = "warning"
+)
+
+// List of the rules checked
+const (
+	RuleSyntax    = "syntax"
+	RuleRequired  = "required"
+	RuleType      = "type"
+	RuleEnum      = "enum"
+	RuleFormat    = "format"
+	RuleRange     = "range"
+	RuleUnique    = "unique"
+	RuleReference = "reference"
+
+	// Values of different feeds contradict each other
+	RuleConsistency = "consistency"
+)
+
+type (
+	// Violation of a rule of the spec
+	Violation struct {
+		// JSON path of the field (e.g. data.stations[2].lat)
+		Path string `json:"path"`
+
+		Severity Severity `json:"severity"`
+
+		// One of the Rule constants
+		Rule string `json:"rule"`
+
+		Message string `json:"message"`
+	}
+
+	// Violations found in one or many feeds
+	Violations []Violation
+)
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s (%s) %s", v.Severity, v.Path, v.Rule, v.Message)
+}
+
+// HasErrors return true when at least one violation has the SeverityError
+func (vv Violations) HasErrors() bool {
+	for _, v := range vv {
+		if v.Severity == SeverityError {
+			return true
+		}
+	}
+
+	return false
+}
+
+// collector accumulate the violations of a feed
+type collector struct {
+	vv Violations
+}
+
+func (c *collector) add(path string, s Severity, rule, format string, args ...interface{}) {
+	c.vv = append(c.vv, Violation{Path: path, Severity: s, Rule: rule, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *collector) required(path, v string) {
+	if strings.TrimSpace(v) == "" {
+		c.add(path, SeverityError, RuleRequired, "required field is missing or empty")
+	}
+}
+
+func (c *collector) nonNegative(path string, v int) {
+	if v < 0 {
+		c.add(path, SeverityError, RuleRange, "must be non-negative, got %d", v)
+	}
+}
+
+func (c *collector) position(path string, lat, lon float64) {
+	if lat < -90 || lat > 90 {
+		c.add(path+".lat", SeverityError, RuleRange, "latitude must be between -90 and 90, got %f", lat)
+	}
+
+	if lon < -180 || lon > 180 {
+		c.add(path+".lon", SeverityError, RuleRange, "longitude must be between -180 and 180, got %f", lon)
+	}
+
+	if lat == 0 && lon == 0 {
+		c.add(path, SeverityWarning, RuleRange, "position is 0,0, probably a missing position")
+	}
+}
+
+// unique report the identifier already seen
+func (c *collector) unique(path, id string, seen map[string]bool) {
+	if id == "" {
+		return
+	}
+
+	if seen[id] {
+		c.add(path, SeverityError, RuleUnique, "identifier '%s' is duplicated", id)
+	}
+
+	seen[id] = true
+}
+
+func index(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
@@ -0,0 +1,39 @@
+package validate
+
+import "testing"
+
+func TestViolations_HasErrors(t *testing.T) {
+	ii := []struct {
+		in  Violations
+		out bool
+	}{
+		{in: nil, out: false},
+		{in: Violations{{Severity: SeverityWarning}}, out: false},
+		{in: Violations{{Severity: SeverityWarning}, {Severity: SeverityError}}, out: true},
+	}
+
+	for _, i := range ii {
+		if o := i.in.HasErrors(); o != i.out {
+			t.Errorf("expect '%t' got '%t'", i.out, o)
+		}
+	}
+}
+
+func TestViolation_String(t *testing.T) {
+	v := Violation{Path: "data.stations[0].lat", Severity: SeverityError, Rule: RuleRange, Message: "out of range"}
+
+	if s := v.String(); s != "error: data.stations[0].lat (range) out of range" {
+		t.Errorf("expect 'error: data.stations[0].lat (range) out of range' got '%s'", s)
+	}
+}
+
+// has return true when a violation match the path and the rule
+func has(vv Violations, path, rule string) bool {
+	for _, v := range vv {
+		if v.Path == path && v.Rule == rule {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,233 @@
+package gbfs
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+	spec30 "github.com/Eraac/gbfs/spec/v3.0"
+)
+
+// SupportedVersions list the versions of the spec with a package in this library, in increasing order
+var SupportedVersions = []string{"1.0", "1.1", "2.0", "2.1", "2.2", "2.3", "3.0"}
+
+type (
+	// discoveryFeed is the auto-discovery feed of any version
+	discoveryFeed struct {
+		// Timestamp of the 3.0 accept the POSIX timestamps of the previous versions
+		spec30.Metadata
+
+		// Feeds listed by language, until the version 3.0
+		Languages map[string]gbfsspec.GBFSLanguage
+
+		// Feeds listed, since the version 3.0
+		Feeds []spec30.GBFSFeed
+	}
+)
+
+// UnmarshalJSON decode the feeds listed by language, or directly in the data object since the version 3.0
+func (f *discoveryFeed) UnmarshalJSON(bs []byte) error {
+	var v struct {
+		spec30.Metadata
+
+		Data json.RawMessage `json:"data"`
+	}
+
+	if err := json.Unmarshal(bs, &v); err != nil {
+		return err
+	}
+
+	f.Metadata = v.Metadata
+
+	var flat spec30.GBFSData
+	if err := json.Unmarshal(v.Data, &flat); err == nil && flat.Feeds != nil {
+		f.Feeds = flat.Feeds
+		return nil
+	}
+
+	var d gbfsspec.GBFSData
+	if err := json.Unmarshal(v.Data, &d); err != nil {
+		return err
+	}
+
+	f.Languages = d.Languages
+
+	return nil
+}
+
+// urls return the URL of each feed listed for the language 'lang' (ignored since the version 3.0)
+func (f *discoveryFeed) urls(lang string) (map[string]string, error) {
+	if f.Languages == nil {
+		urls := make(map[string]string, len(f.Feeds))
+		for _, feed := range f.Feeds {
+			urls[feed.Name] = feed.URL
+		}
+
+		return urls, nil
+	}
+
+	l, err := pickLanguage(gbfsspec.GBFSData{Languages: f.Languages}, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make(map[string]string, len(l.Feeds))
+	for _, feed := range l.Feeds {
+		urls[feed.Name] = feed.URL
+	}
+
+	return urls, nil
+}
+
+// find return the URL of the feed 'key' listed in any language
+func (f *discoveryFeed) find(key string) (string, bool) {
+	for _, feed := range f.Feeds {
+		if feed.Name == key {
+			return feed.URL, true
+		}
+	}
+
+	for _, l := range f.Languages {
+		for _, feed := range l.Feeds {
+			if feed.Name == key {
+				return feed.URL, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// negotiate fetch the auto-discovery feed at 'u', then choose a version from the gbfs_versions feed it list
+// The auto-discovery URL is switched to the one of the version chosen, the auto-discovery feed is returned
+// when it's the same URL (nil otherwise) with the new URL
+func (c *HTTPClient) negotiate(ctx context.Context, u string) (*discoveryFeed, string, error) {
+	var f discoveryFeed
+	if err := c.fetchWithRetry(ctx, gbfsspec.FeedKeyAutoDiscovery, u, &f, false); err != nil {
+		return nil, "", err
+	}
+
+	// without gbfs_versions only the version of the auto-discovery feed is available
+	// the version field doesn't exist in 1.0
+	v := f.Version
+	if v == "" {
+		v = "1.0"
+	}
+
+	vv := []gbfsspec.GBFSVersion{{Version: v, URL: u}}
+
+	if vu, ok := f.find(gbfsspec.FeedKeyGBFSVersions); ok {
+		var versions gbfsspec.FeedGBFSVersions
+		if err := c.fetchWithRetry(ctx, gbfsspec.FeedKeyGBFSVersions, vu, &versions, false); err != nil {
+			return nil, "", err
+		}
+
+		vv = versions.Data.Versions
+	}
+
+	chosen, err := chooseVersion(vv, c.preferences)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if chosen.URL == "" {
+		chosen.URL = u
+	}
+
+	c.mu.Lock()
+	c.version = chosen.Version
+	c.autoDiscoveryURL = chosen.URL
+	c.mu.Unlock()
+
+	if chosen.URL != u {
+		return nil, chosen.URL, nil
+	}
+
+	return &f, u, nil
+}
+
+// chooseVersion return the first version of 'preferences' available, or when there is no preference
+// the highest supported version available who can be decoded with the spec 2.0 (see decodableAsV20)
+func chooseVersion(available []gbfsspec.GBFSVersion, preferences []string) (gbfsspec.GBFSVersion, error) {
+	if len(preferences) == 0 {
+		for i := len(SupportedVersions) - 1; i >= 0; i-- {
+			if decodableAsV20(SupportedVersions[i]) {
+				preferences = append(preferences, SupportedVersions[i])
+			}
+		}
+	}
+
+	for _, p := range preferences {
+		for _, v := range available {
+			if v.Version == p {
+				return v, nil
+			}
+		}
+	}
+
+	return gbfsspec.GBFSVersion{}, ErrVersionNotSupported
+}
+
+// Version return the version of the spec of the feeds, negotiated when enabled (see HTTPOptionVersionNegotiation)
+// or read from the auto-discovery feed. Empty until the auto-discovery feed is fetched, or without auto-discovery.
+// Use it to choose the spec package to decode the feeds into.
+func (c *HTTPClient) Version() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.version != "" {
+		return c.version
+	}
+
+	if c.discovery != nil {
+		return c.discovery.Version
+	}
+
+	return ""
+}
+
+// Negotiate fetch the auto-discovery feed, negotiating the version when enabled, and return the version of the spec
+// Useful to know the version before fetching the first feed
+func (c *HTTPClient) Negotiate(ctx context.Context) (string, error) {
+	if c.autoDiscovery() == "" {
+		return "", ErrAutoDiscoveryMissing
+	}
+
+	if err := c.discover(ctx); err != nil {
+		return "", err
+	}
+
+	return c.Version(), nil
+}
+
+// decodableAsV20 return true when the feeds of the version 'v' can be decoded with the spec 2.0 structures
+// (versions 1.x and 2.x), or when the version is unknown
+func decodableAsV20(v string) bool {
+	if v == "" {
+		return true
+	}
+
+	major := v
+	if i := strings.Index(v, "."); i >= 0 {
+		major = v[:i]
+	}
+
+	n, err := strconv.Atoi(major)
+
+	return err == nil && n <= 2
+}
+
+// HTTPOptionVersionNegotiation enable the version negotiation, require HTTPOptionAutoDiscovery
+// Before the first fetch, the versions are read from the gbfs_versions feed listed by the auto-discovery feed,
+// then the auto-discovery URL is switched to the gbfs.json of the version chosen: the first of 'preferences'
+// published by the provider, or when no preference is given the highest 1.x or 2.x version of SupportedVersions,
+// the versions decoded by FetchSystem and the helpers of this package (spec 2.0 structures).
+// A 3.x version must be given as preference. ErrVersionNotSupported is returned when no version match.
+func HTTPOptionVersionNegotiation(preferences ...string) HTTPOption {
+	return func(c *HTTPClient) {
+		c.negotiation = true
+		c.preferences = preferences
+	}
+}
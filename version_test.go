@@ -0,0 +1,280 @@
+package gbfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	gbfsspec "github.com/Eraac/gbfs/spec/v2.0"
+)
+
+func newVersionServer(t *testing.T) *httptest.Server {
+	var s *httptest.Server
+
+	s = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2.2/gbfs.json":
+			_, _ = fmt.Fprintf(w, `{"last_updated": 1589230640, "ttl": 0, "version": "2.2", "data": {"en": {"feeds": [
+				{"name": "gbfs_versions", "url": "%[1]s/gbfs_versions.json"},
+				{"name": "system_information", "url": "%[1]s/2.2/system_information.json"}
+			]}}}`, s.URL)
+		case "/3.0/gbfs.json":
+			_, _ = fmt.Fprintf(w, `{"last_updated": "2020-05-11T20:57:20Z", "ttl": 0, "version": "3.0", "data": {"feeds": [
+				{"name": "gbfs_versions", "url": "%[1]s/gbfs_versions.json"},
+				{"name": "system_information", "url": "%[1]s/3.0/system_information.json"}
+			]}}`, s.URL)
+		case "/gbfs_versions.json":
+			_, _ = fmt.Fprintf(w, `{"last_updated": 1589230640, "ttl": 0, "data": {"versions": [
+				{"version": "2.2", "url": "%[1]s/2.2/gbfs.json"},
+				{"version": "3.0", "url": "%[1]s/3.0/gbfs.json"},
+				{"version": "9.0", "url": "%[1]s/9.0/gbfs.json"}
+			]}}`, s.URL)
+		case "/2.2/system_information.json", "/3.0/system_information.json":
+			_, _ = fmt.Fprintf(w, `{"last_updated": 1589230640, "ttl": 0, "data": {"system_id": "%s"}}`, r.URL.Path[1:4])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	return s
+}
+
+func TestHTTPClient_VersionNegotiation(t *testing.T) {
+	s := newVersionServer(t)
+	defer s.Close()
+
+	ii := []struct {
+		preferences []string
+		version     string
+		err         error
+	}{
+		{version: "2.2"},
+		{preferences: []string{"3.0", "2.2"}, version: "3.0"},
+		{preferences: []string{"2.2", "3.0"}, version: "2.2"},
+		{preferences: []string{"1.1", "2.2"}, version: "2.2"},
+		{preferences: []string{"1.1"}, err: ErrVersionNotSupported},
+	}
+
+	for _, i := range ii {
+		c, err := NewHTTPClient(
+			HTTPOptionAutoDiscovery(s.URL+"/2.2/gbfs.json"),
+			HTTPOptionVersionNegotiation(i.preferences...),
+		)
+		if err != nil {
+			t.Errorf("expect 'nil' got '%s'", err)
+			t.FailNow()
+		}
+
+		var si gbfsspec.FeedSystemInformation
+		err = c.Get(gbfsspec.FeedKeySystemInformation, &si)
+		if !errors.Is(err, i.err) {
+			t.Errorf("expect '%v' got '%v'", i.err, err)
+			continue
+		}
+
+		if i.err != nil {
+			continue
+		}
+
+		if v := c.(*HTTPClient).Version(); v != i.version {
+			t.Errorf("expect '%s' got '%s'", i.version, v)
+		}
+
+		if si.Data.SystemID != i.version {
+			t.Errorf("expect '%s' got '%s'", i.version, si.Data.SystemID)
+		}
+	}
+}
+
+func TestHTTPClient_Negotiate(t *testing.T) {
+	s := newVersionServer(t)
+	defer s.Close()
+
+	c, err := NewHTTPClient(HTTPOptionAutoDiscovery(s.URL + "/3.0/gbfs.json"))
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	// without negotiation, the version of the auto-discovery feed
+	v, err := c.(*HTTPClient).Negotiate(context.Background())
+	if err != nil || v != "3.0" {
+		t.Errorf("expect '3.0' got '%s' (%v)", v, err)
+	}
+
+	if _, err := NewHTTPClient(HTTPOptionBaseURL(s.URL), HTTPOptionVersionNegotiation()); err != ErrAutoDiscoveryMissing {
+		t.Errorf("expect '%s' got '%v'", ErrAutoDiscoveryMissing, err)
+	}
+}
+
+func TestHTTPClient_VersionNegotiationConcurrent(t *testing.T) {
+	s := newVersionServer(t)
+	defer s.Close()
+
+	c, err := NewHTTPClient(
+		HTTPOptionAutoDiscovery(s.URL+"/2.2/gbfs.json"),
+		HTTPOptionVersionNegotiation("3.0"),
+	)
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	var wg sync.WaitGroup
+
+	// the goroutines started later read the auto-discovery URL switched by the negotiation of the first ones
+	for n := 0; n < 4; n++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			var si gbfsspec.FeedSystemInformation
+			if err := c.Get(gbfsspec.FeedKeySystemInformation, &si); err != nil {
+				t.Errorf("expect 'nil' got '%s'", err)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			if _, err := c.(*HTTPClient).Negotiate(context.Background()); err != nil {
+				t.Errorf("expect 'nil' got '%s'", err)
+			}
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	wg.Wait()
+
+	if v := c.(*HTTPClient).Version(); v != "3.0" {
+		t.Errorf("expect '3.0' got '%s'", v)
+	}
+}
+
+func TestCachingClient_Negotiate(t *testing.T) {
+	s := newVersionServer(t)
+	defer s.Close()
+
+	hc, err := NewHTTPClient(HTTPOptionAutoDiscovery(s.URL + "/3.0/gbfs.json"))
+	if err != nil {
+		t.Errorf("expect 'nil' got '%s'", err)
+		t.FailNow()
+	}
+
+	c, ok := NewCachingClient(hc).(VersionedClient)
+	if !ok {
+		t.Errorf("expect CachingClient to implement VersionedClient")
+		t.FailNow()
+	}
+
+	if v, err := c.Negotiate(context.Background()); err != nil || v != "3.0" {
+		t.Errorf("expect '3.0' got '%s' (%v)", v, err)
+	}
+
+	if v := c.Version(); v != "3.0" {
+		t.Errorf("expect '3.0' got '%s'", v)
+	}
+
+	// the wrapped client doesn't know the version
+	c = NewCachingClient(&fakeClient{}).(VersionedClient)
+
+	if _, err := c.Negotiate(context.Background()); err != ErrAutoDiscoveryMissing {
+		t.Errorf("expect '%s' got '%v'", ErrAutoDiscoveryMissing, err)
+	}
+}
+
+func TestFetchSystem_version(t *testing.T) {
+	s := newVersionServer(t)
+	defer s.Close()
+
+	ii := []struct {
+		preferences []string
+		err         error
+	}{
+		{},
+		{preferences: []string{"2.2"}},
+		{preferences: []string{"3.0"}, err: ErrVersionNotSupported},
+	}
+
+	for _, i := range ii {
+		c, err := NewHTTPClient(
+			HTTPOptionAutoDiscovery(s.URL+"/2.2/gbfs.json"),
+			HTTPOptionVersionNegotiation(i.preferences...),
+		)
+		if err != nil {
+			t.Errorf("expect 'nil' got '%s'", err)
+			t.FailNow()
+		}
+
+		sys, err := FetchSystem(context.Background(), c, 0)
+		if !errors.Is(err, i.err) {
+			t.Errorf("expect '%v' got '%v'", i.err, err)
+			continue
+		}
+
+		if i.err == nil && sys.SystemInformation.Data.SystemID != "2.2" {
+			t.Errorf("expect '2.2' got '%s'", sys.SystemInformation.Data.SystemID)
+		}
+	}
+}
+
+func TestDecodableAsV20(t *testing.T) {
+	ii := []struct {
+		in  string
+		out bool
+	}{
+		{in: "", out: true},
+		{in: "1.0", out: true},
+		{in: "2.3", out: true},
+		{in: "3.0", out: false},
+		{in: "10.1", out: false},
+		{in: "x", out: false},
+	}
+
+	for _, i := range ii {
+		if got := decodableAsV20(i.in); got != i.out {
+			t.Errorf("expect '%t' got '%t' for '%s'", i.out, got, i.in)
+		}
+	}
+}
+
+func TestChooseVersion(t *testing.T) {
+	available := []gbfsspec.GBFSVersion{{Version: "1.1"}, {Version: "2.3"}, {Version: "4.0"}}
+
+	ii := []struct {
+		available   []gbfsspec.GBFSVersion
+		preferences []string
+		out         string
+		err         error
+	}{
+		{out: "2.3"},
+		{preferences: []string{"1.1"}, out: "1.1"},
+		// only 3.0 published, it must be asked explicitly
+		{available: []gbfsspec.GBFSVersion{{Version: "3.0"}}, err: ErrVersionNotSupported},
+		{available: []gbfsspec.GBFSVersion{{Version: "3.0"}}, preferences: []string{"3.0"}, out: "3.0"},
+		{preferences: []string{"4.0"}, out: "4.0"},
+		{preferences: []string{"2.0"}, err: ErrVersionNotSupported},
+	}
+
+	for _, i := range ii {
+		if i.available == nil {
+			i.available = available
+		}
+
+		v, err := chooseVersion(i.available, i.preferences)
+		if err != i.err {
+			t.Errorf("expect '%v' got '%v'", i.err, err)
+		}
+
+		if v.Version != i.out {
+			t.Errorf("expect '%s' got '%s'", i.out, v.Version)
+		}
+	}
+}